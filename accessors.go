@@ -0,0 +1,547 @@
+package graphson
+
+import (
+	"math/big"
+	"time"
+)
+
+// The accessors below are the error-returning counterparts of the As methods. Rather than a zero value they return a
+// *TypeMismatchError naming the ValueType asked for and the one held when the ValuePair can't be read as that type.
+// Numeric accessors also accept the narrower types that widen to them without loss, so Int64 reads an Int32 and Float64
+// reads a Float. The numeric accessors are named after the Go type they return, a Double holding a float64 and a Float a
+// float32.
+
+func (vp ValuePair) mismatch(expected ValueType) error {
+	return &TypeMismatchError{Expected: expected, Actual: vp.Type}
+}
+
+// Set returns the elements of a g:Set, expanding a g:BulkSet as AsSet does. A g:BulkSet too large to expand returns the
+// error from Expand.
+func (vp ValuePair) Set() ([]ValuePair, error) {
+	switch v := vp.Value.(type) {
+	case []ValuePair:
+		if vp.Type == Set {
+			return v, nil
+		}
+	case BulkSetRecord:
+		if vp.Type == BulkSet {
+			return v.Expand()
+		}
+	}
+
+	return nil, vp.mismatch(Set)
+}
+
+// Int64 returns an Int64, or an Int32, Short or Byte widened to an int64.
+func (vp ValuePair) Int64() (int64, error) {
+	switch v := vp.Value.(type) {
+	case int64:
+		if vp.Type == Int64 {
+			return v, nil
+		}
+	case int:
+		if vp.Type == Int32 {
+			return int64(v), nil
+		}
+	case int16:
+		if vp.Type == Short {
+			return int64(v), nil
+		}
+	case int8:
+		if vp.Type == Byte {
+			return int64(v), nil
+		}
+	}
+
+	return 0, vp.mismatch(Int64)
+}
+
+// Int32 returns an Int32, or a Short or Byte widened to an int. The int matches the Go type AsInt32 returns.
+func (vp ValuePair) Int32() (int, error) {
+	switch v := vp.Value.(type) {
+	case int:
+		if vp.Type == Int32 {
+			return v, nil
+		}
+	case int16:
+		if vp.Type == Short {
+			return int(v), nil
+		}
+	case int8:
+		if vp.Type == Byte {
+			return int(v), nil
+		}
+	}
+
+	return 0, vp.mismatch(Int32)
+}
+
+// Short returns a Short, or a Byte widened to an int16.
+func (vp ValuePair) Short() (int16, error) {
+	switch v := vp.Value.(type) {
+	case int16:
+		if vp.Type == Short {
+			return v, nil
+		}
+	case int8:
+		if vp.Type == Byte {
+			return int16(v), nil
+		}
+	}
+
+	return 0, vp.mismatch(Short)
+}
+
+// Float64 returns a Double, or a Float widened to a float64.
+func (vp ValuePair) Float64() (float64, error) {
+	switch v := vp.Value.(type) {
+	case float64:
+		if vp.Type == Double {
+			return v, nil
+		}
+	case float32:
+		if vp.Type == Float {
+			return float64(v), nil
+		}
+	}
+
+	return 0, vp.mismatch(Double)
+}
+
+// Float32 returns a Float, the ValueType holding a float32.
+func (vp ValuePair) Float32() (float32, error) {
+	v, ok := vp.Value.(float32)
+	if vp.Type != Float || !ok {
+		return 0, vp.mismatch(Float)
+	}
+
+	return v, nil
+}
+
+// BigInteger returns a BigInteger, or any of the integer types as a *big.Int.
+func (vp ValuePair) BigInteger() (*big.Int, error) {
+	if v, ok := vp.Value.(*big.Int); ok && vp.Type == BigInteger {
+		return v, nil
+	}
+
+	if n, err := vp.Int64(); err == nil {
+		return big.NewInt(n), nil
+	}
+
+	return nil, vp.mismatch(BigInteger)
+}
+
+// BigDecimal returns a BigDecimal, or any of the integer and floating point types as a *big.Float.
+func (vp ValuePair) BigDecimal() (*big.Float, error) {
+	if v, ok := vp.Value.(*big.Float); ok && vp.Type == BigDecimal {
+		return v, nil
+	}
+
+	if n, err := vp.Float64(); err == nil {
+		return big.NewFloat(n), nil
+	}
+
+	if n, err := vp.BigInteger(); err == nil {
+		return new(big.Float).SetInt(n), nil
+	}
+
+	return nil, vp.mismatch(BigDecimal)
+}
+
+// Time returns a Timestamp or a Date, both being a point in time to millisecond precision.
+func (vp ValuePair) Time() (time.Time, error) {
+	v, ok := vp.Value.(time.Time)
+	if (vp.Type != Timestamp && vp.Type != Date) || !ok {
+		return time.Time{}, vp.mismatch(Timestamp)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Vertex() (VertexRecord, error) {
+	v, ok := vp.Value.(VertexRecord)
+	if vp.Type != Vertex || !ok {
+		return VertexRecord{}, vp.mismatch(Vertex)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) VertexProperty() (VertexPropertyRecord, error) {
+	v, ok := vp.Value.(VertexPropertyRecord)
+	if vp.Type != VertexProperty || !ok {
+		return VertexPropertyRecord{}, vp.mismatch(VertexProperty)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Edge() (EdgeRecord, error) {
+	v, ok := vp.Value.(EdgeRecord)
+	if vp.Type != Edge || !ok {
+		return EdgeRecord{}, vp.mismatch(Edge)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Property() (Property, error) {
+	v, ok := vp.Value.(Property)
+	if vp.Type != EdgeProperty || !ok {
+		return Property{}, vp.mismatch(EdgeProperty)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Path() (PathRecord, error) {
+	v, ok := vp.Value.(PathRecord)
+	if vp.Type != Path || !ok {
+		return PathRecord{}, vp.mismatch(Path)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Tree() (TreeRecord, error) {
+	v, ok := vp.Value.(TreeRecord)
+	if vp.Type != Tree || !ok {
+		return TreeRecord{}, vp.mismatch(Tree)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) BulkSet() (BulkSetRecord, error) {
+	v, ok := vp.Value.(BulkSetRecord)
+	if vp.Type != BulkSet || !ok {
+		return BulkSetRecord{}, vp.mismatch(BulkSet)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Traverser() (TraverserRecord, error) {
+	v, ok := vp.Value.(TraverserRecord)
+	if vp.Type != Traverser || !ok {
+		return TraverserRecord{}, vp.mismatch(Traverser)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) TraversalMetrics() (TraversalMetricsRecord, error) {
+	v, ok := vp.Value.(TraversalMetricsRecord)
+	if vp.Type != TraversalMetrics || !ok {
+		return TraversalMetricsRecord{}, vp.mismatch(TraversalMetrics)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Metrics() (MetricsRecord, error) {
+	v, ok := vp.Value.(MetricsRecord)
+	if vp.Type != Metrics || !ok {
+		return MetricsRecord{}, vp.mismatch(Metrics)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) TraversalExplanation() (TraversalExplanationRecord, error) {
+	v, ok := vp.Value.(TraversalExplanationRecord)
+	if vp.Type != TraversalExplanation || !ok {
+		return TraversalExplanationRecord{}, vp.mismatch(TraversalExplanation)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Map() (MapRecord, error) {
+	v, ok := vp.Value.(MapRecord)
+	if vp.Type != Map || !ok {
+		return MapRecord{}, vp.mismatch(Map)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) List() ([]ValuePair, error) {
+	v, ok := vp.Value.([]ValuePair)
+	if vp.Type != List || !ok {
+		return nil, vp.mismatch(List)
+	}
+
+	return v, nil
+}
+
+// StringValue returns a String. It isn't named String so that the name stays free for fmt.Stringer.
+func (vp ValuePair) StringValue() (string, error) {
+	v, ok := vp.Value.(string)
+	if vp.Type != String || !ok {
+		return "", vp.mismatch(String)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Bool() (bool, error) {
+	v, ok := vp.Value.(bool)
+	if vp.Type != Boolean || !ok {
+		return false, vp.mismatch(Boolean)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Class() (string, error) {
+	v, ok := vp.Value.(string)
+	if vp.Type != Class || !ok {
+		return "", vp.mismatch(Class)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) UUID() (string, error) {
+	v, ok := vp.Value.(string)
+	if vp.Type != UUID || !ok {
+		return "", vp.mismatch(UUID)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) T() (TToken, error) {
+	v, ok := vp.Value.(TToken)
+	if vp.Type != T || !ok {
+		return "", vp.mismatch(T)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Direction() (DirectionToken, error) {
+	v, ok := vp.Value.(DirectionToken)
+	if vp.Type != Direction || !ok {
+		return "", vp.mismatch(Direction)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Cardinality() (CardinalityToken, error) {
+	v, ok := vp.Value.(CardinalityToken)
+	if vp.Type != Cardinality || !ok {
+		return "", vp.mismatch(Cardinality)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Order() (OrderToken, error) {
+	v, ok := vp.Value.(OrderToken)
+	if vp.Type != Order || !ok {
+		return "", vp.mismatch(Order)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Pop() (PopToken, error) {
+	v, ok := vp.Value.(PopToken)
+	if vp.Type != Pop || !ok {
+		return "", vp.mismatch(Pop)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Scope() (ScopeToken, error) {
+	v, ok := vp.Value.(ScopeToken)
+	if vp.Type != Scope || !ok {
+		return "", vp.mismatch(Scope)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Column() (ColumnToken, error) {
+	v, ok := vp.Value.(ColumnToken)
+	if vp.Type != Column || !ok {
+		return "", vp.mismatch(Column)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Barrier() (BarrierToken, error) {
+	v, ok := vp.Value.(BarrierToken)
+	if vp.Type != Barrier || !ok {
+		return "", vp.mismatch(Barrier)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Operator() (OperatorToken, error) {
+	v, ok := vp.Value.(OperatorToken)
+	if vp.Type != Operator || !ok {
+		return "", vp.mismatch(Operator)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Pick() (PickToken, error) {
+	v, ok := vp.Value.(PickToken)
+	if vp.Type != Pick || !ok {
+		return "", vp.mismatch(Pick)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Byte() (int8, error) {
+	v, ok := vp.Value.(int8)
+	if vp.Type != Byte || !ok {
+		return 0, vp.mismatch(Byte)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) ByteBuffer() ([]byte, error) {
+	v, ok := vp.Value.([]byte)
+	if vp.Type != ByteBuffer || !ok {
+		return nil, vp.mismatch(ByteBuffer)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Char() (rune, error) {
+	v, ok := vp.Value.(rune)
+	if vp.Type != Char || !ok {
+		return 0, vp.mismatch(Char)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Duration() (time.Duration, error) {
+	v, ok := vp.Value.(time.Duration)
+	if vp.Type != Duration || !ok {
+		return 0, vp.mismatch(Duration)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Instant() (time.Time, error) {
+	v, ok := vp.Value.(time.Time)
+	if vp.Type != Instant || !ok {
+		return time.Time{}, vp.mismatch(Instant)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) LocalDate() (LocalDateRecord, error) {
+	v, ok := vp.Value.(LocalDateRecord)
+	if vp.Type != LocalDate || !ok {
+		return LocalDateRecord{}, vp.mismatch(LocalDate)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) LocalDateTime() (LocalDateTimeRecord, error) {
+	v, ok := vp.Value.(LocalDateTimeRecord)
+	if vp.Type != LocalDateTime || !ok {
+		return LocalDateTimeRecord{}, vp.mismatch(LocalDateTime)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) LocalTime() (LocalTimeRecord, error) {
+	v, ok := vp.Value.(LocalTimeRecord)
+	if vp.Type != LocalTime || !ok {
+		return LocalTimeRecord{}, vp.mismatch(LocalTime)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) MonthDay() (MonthDayRecord, error) {
+	v, ok := vp.Value.(MonthDayRecord)
+	if vp.Type != MonthDay || !ok {
+		return MonthDayRecord{}, vp.mismatch(MonthDay)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) OffsetDateTime() (time.Time, error) {
+	v, ok := vp.Value.(time.Time)
+	if vp.Type != OffsetDateTime || !ok {
+		return time.Time{}, vp.mismatch(OffsetDateTime)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) OffsetTime() (OffsetTimeRecord, error) {
+	v, ok := vp.Value.(OffsetTimeRecord)
+	if vp.Type != OffsetTime || !ok {
+		return OffsetTimeRecord{}, vp.mismatch(OffsetTime)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Period() (PeriodRecord, error) {
+	v, ok := vp.Value.(PeriodRecord)
+	if vp.Type != Period || !ok {
+		return PeriodRecord{}, vp.mismatch(Period)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) Year() (int, error) {
+	v, ok := vp.Value.(int)
+	if vp.Type != Year || !ok {
+		return 0, vp.mismatch(Year)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) YearMonth() (YearMonthRecord, error) {
+	v, ok := vp.Value.(YearMonthRecord)
+	if vp.Type != YearMonth || !ok {
+		return YearMonthRecord{}, vp.mismatch(YearMonth)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) ZonedDateTime() (time.Time, error) {
+	v, ok := vp.Value.(time.Time)
+	if vp.Type != ZonedDateTime || !ok {
+		return time.Time{}, vp.mismatch(ZonedDateTime)
+	}
+
+	return v, nil
+}
+
+func (vp ValuePair) ZoneOffset() (ZoneOffsetRecord, error) {
+	v, ok := vp.Value.(ZoneOffsetRecord)
+	if vp.Type != ZoneOffset || !ok {
+		return ZoneOffsetRecord{}, vp.mismatch(ZoneOffset)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,99 @@
+package graphson
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedAccessors(t *testing.T) {
+	vp := testVertex()
+
+	vertex, err := vp.Vertex()
+	assert.Nil(t, err)
+	assert.Equal(t, "person", vertex.Label)
+
+	_, err = vp.Edge()
+	var mismatch *TypeMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, Edge, mismatch.Expected)
+	assert.Equal(t, Vertex, mismatch.Actual)
+	assert.Equal(t, "type mismatch: expected Edge, got Vertex", err.Error())
+
+	s, err := ValuePair{Type: String, Value: "marko"}.StringValue()
+	assert.Nil(t, err)
+	assert.Equal(t, "marko", s)
+
+	timestamp := ValuePair{Type: Timestamp, Value: time.UnixMilli(1481750076295)}
+	when, err := timestamp.Time()
+	assert.Nil(t, err)
+	assert.Equal(t, timestamp.AsTime(), when)
+
+	date := ValuePair{Type: Date, Value: time.UnixMilli(1481750076295)}
+	_, err = date.Time()
+	assert.Nil(t, err)
+
+	_, err = ValuePair{Type: String, Value: "2016-12-14"}.Time()
+	assert.NotNil(t, err)
+
+	// a ValuePair whose Value doesn't match its Type is a mismatch rather than a panic
+	_, err = ValuePair{Type: Vertex, Value: "marko"}.Vertex()
+	assert.NotNil(t, err)
+
+	_, err = ValuePair{}.Duration()
+	assert.NotNil(t, err)
+	_, err = ValuePair{Type: Duration, Value: time.Second}.Duration()
+	assert.Nil(t, err)
+}
+
+func TestTypedAccessorsWidening(t *testing.T) {
+	int32VP := ValuePair{Type: Int32, Value: 7}
+	int64VP := ValuePair{Type: Int64, Value: int64(1) << 40}
+	byteVP := ValuePair{Type: Byte, Value: int8(-3)}
+	doubleVP := ValuePair{Type: Double, Value: 1.5}
+	floatVP := ValuePair{Type: Float, Value: float32(2.25)}
+
+	n, err := int32VP.Int64()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), n)
+
+	n, err = byteVP.Int64()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-3), n)
+
+	_, err = int64VP.Int32()
+	assert.NotNil(t, err, "narrowing is not allowed")
+
+	short, err := byteVP.Short()
+	assert.Nil(t, err)
+	assert.Equal(t, int16(-3), short)
+
+	f, err := doubleVP.Float64()
+	assert.Nil(t, err)
+	assert.Equal(t, 1.5, f)
+
+	f, err = floatVP.Float64()
+	assert.Nil(t, err)
+	assert.Equal(t, 2.25, f)
+
+	_, err = doubleVP.Float32()
+	assert.NotNil(t, err, "narrowing is not allowed")
+
+	_, err = int32VP.Float64()
+	assert.NotNil(t, err)
+
+	i, err := int64VP.BigInteger()
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1<<40), i)
+
+	d, err := floatVP.BigDecimal()
+	assert.Nil(t, err)
+	assert.Equal(t, "2.25", d.Text('f', -1))
+
+	d, err = int32VP.BigDecimal()
+	assert.Nil(t, err)
+	assert.Equal(t, "7", d.Text('f', -1))
+}
@@ -0,0 +1,198 @@
+package graphson
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Decoder reads the elements of a GraphSON list from an io.Reader one at a time, so that a large result set never has to
+// be held in memory whole. Only the element currently being parsed is buffered. The input may be a bare list, either a
+// typed g:List/g:Set or a plain JSON array, or a complete response message, in which case the list in result.data is
+// read.
+//
+// The status of a response message is checked wherever it's written. A status that isn't a success is returned by Next
+// as a ResponseError once it has been read, before the first element when it precedes the result or in place of io.EOF
+// when it follows it. Each element is handed to the parser as the raw JSON it was written as and parsed on its own, so
+// options applying to whole lists, such as the GraphSON 3 parser's UnwrapTraversers, have no effect and traversers are
+// returned as they are.
+type Decoder struct {
+	dec    *json.Decoder
+	parser GraphSONParser
+	opened bool
+	done   bool
+	depth  int // the objects enclosing the list, read to their end once the list has been
+}
+
+// NewDecoder returns a Decoder reading from r, each element being parsed with parser.
+func NewDecoder(r io.Reader, parser GraphSONParser) *Decoder {
+	return &Decoder{dec: json.NewDecoder(r), parser: parser}
+}
+
+// Next returns the next element of the list. io.EOF is returned once the list has been read completely, or straight
+// away for a null list such as the data of a 204 response.
+func (d *Decoder) Next() (ValuePair, error) {
+	if d.done {
+		return ValuePair{}, io.EOF
+	}
+
+	if !d.opened {
+		if err := d.open(); err != nil {
+			d.done = true
+
+			// a null list may still be followed by the status of a failed request
+			if err == io.EOF {
+				if err := d.close(); err != nil {
+					return ValuePair{}, err
+				}
+			}
+
+			return ValuePair{}, err
+		}
+
+		d.opened = true
+	}
+
+	if !d.dec.More() {
+		d.done = true
+
+		// consume the closing bracket so that a malformed document is still reported
+		if _, err := d.dec.Token(); err != nil {
+			return ValuePair{}, ParsingError{Message: err.Error(), Operation: "decoderNext", Field: "]"}
+		}
+
+		if err := d.close(); err != nil {
+			return ValuePair{}, err
+		}
+
+		return ValuePair{}, io.EOF
+	}
+
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		d.done = true
+		return ValuePair{}, ParsingError{Message: err.Error(), Operation: "decoderNext", Field: "element"}
+	}
+
+	return d.parser.Parse(raw)
+}
+
+// open advances the underlying decoder until it sits just inside the array holding the list elements.
+func (d *Decoder) open() error {
+	t, err := d.dec.Token()
+	if err != nil {
+		if err == io.EOF {
+			return ParsingError{Message: "empty input", Operation: "decoderOpen"}
+		}
+
+		return ParsingError{Message: err.Error(), Operation: "decoderOpen"}
+	}
+
+	switch t {
+	case json.Delim('['):
+		return nil
+	case json.Delim('{'):
+		return d.openObject()
+	case nil: // the data of a response with no content
+		return io.EOF
+	}
+
+	return ParsingError{Message: fmt.Sprintf("unexpected token %v, expected a list", t), Operation: "decoderOpen"}
+}
+
+// openObject walks the keys of an object, descending in to @value, result and data, checking the status of a response
+// message and skipping over everything else. An @value is only followed once its @type has been read and found to be a
+// list, as a decoder can't go back to check a type written after the value.
+func (d *Decoder) openObject() error {
+	d.depth++
+	typed := false
+
+	for d.dec.More() {
+		t, err := d.dec.Token()
+		if err != nil {
+			return ParsingError{Message: err.Error(), Operation: "decoderOpen"}
+		}
+
+		key, _ := t.(string)
+
+		switch key {
+		case "@value":
+			if !typed {
+				return ParsingError{Message: "@value read before its @type", Operation: "decoderOpen", Field: key}
+			}
+
+			return d.open()
+		case "result", "data":
+			return d.open()
+		case "@type":
+			var typeName string
+			if err := d.dec.Decode(&typeName); err != nil {
+				return ParsingError{Message: err.Error(), Operation: "decoderOpen", Field: key}
+			}
+
+			if typeName != "g:List" && typeName != "g:Set" {
+				return ParsingError{Message: fmt.Sprintf("cannot stream type %s", typeName), Operation: "decoderOpen", Field: key}
+			}
+
+			typed = true
+		case "status":
+			if err := d.readStatus(); err != nil {
+				return err
+			}
+		default:
+			var skipped json.RawMessage
+			if err := d.dec.Decode(&skipped); err != nil {
+				return ParsingError{Message: err.Error(), Operation: "decoderOpen", Field: key}
+			}
+		}
+	}
+
+	return ParsingError{Message: "no list found", Operation: "decoderOpen"}
+}
+
+// close reads the enclosing objects past the end of the list, so that a status written after the result is checked and
+// a malformed document is reported.
+func (d *Decoder) close() error {
+	for ; d.depth > 0; d.depth-- {
+		for d.dec.More() {
+			t, err := d.dec.Token()
+			if err != nil {
+				return ParsingError{Message: err.Error(), Operation: "decoderClose"}
+			}
+
+			key, _ := t.(string)
+			if key == "status" {
+				if err := d.readStatus(); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			var skipped json.RawMessage
+			if err := d.dec.Decode(&skipped); err != nil {
+				return ParsingError{Message: err.Error(), Operation: "decoderClose", Field: key}
+			}
+		}
+
+		if _, err := d.dec.Token(); err != nil {
+			return ParsingError{Message: err.Error(), Operation: "decoderClose", Field: "}"}
+		}
+	}
+
+	return nil
+}
+
+// readStatus reads the status of a response message, returning the ResponseError of a request that didn't succeed.
+func (d *Decoder) readStatus() error {
+	var status struct {
+		Code    ResponseStatusCode `json:"code"`
+		Message string             `json:"message"`
+	}
+
+	if err := d.dec.Decode(&status); err != nil {
+		return ParsingError{Message: err.Error(), Operation: "decoderStatus", Field: "status"}
+	}
+
+	return ResponseStatus{Code: status.Code, Message: status.Message}.Err()
+}
@@ -41,3 +41,51 @@ func (pe ParsingErrors) Combine() error {
 		strings.Join(operations, ","),
 		strings.Join(fields, ",")}
 }
+
+// ResponseError represents a non-success status returned by Gremlin Server. Compare against the Err* values with
+// errors.Is, only the status code is considered.
+type ResponseError struct {
+	Code    ResponseStatusCode
+	Message string
+}
+
+// Error satisfies the error interface
+func (e ResponseError) Error() string {
+	return fmt.Sprintf("gremlin server responded %d: %s", e.Code, e.Message)
+}
+
+// Is reports whether target is a ResponseError with the same status code
+func (e ResponseError) Is(target error) bool {
+	t, ok := target.(ResponseError)
+	return ok && t.Code == e.Code
+}
+
+var (
+	ErrUnauthorized              = ResponseError{Code: StatusUnauthorized, Message: "unauthorized"}
+	ErrAuthenticate              = ResponseError{Code: StatusAuthenticate, Message: "authentication required"}
+	ErrRequestErrorSerialization = ResponseError{Code: StatusRequestErrorSerialization, Message: "request could not be deserialized"}
+	ErrMalformedRequest          = ResponseError{Code: StatusMalformedRequest, Message: "malformed request"}
+	ErrInvalidRequestArguments   = ResponseError{Code: StatusInvalidRequestArguments, Message: "invalid request arguments"}
+	ErrServerError               = ResponseError{Code: StatusServerError, Message: "server error"}
+	ErrScriptEvaluationError     = ResponseError{Code: StatusScriptEvaluationError, Message: "script evaluation error"}
+	ErrServerTimeout             = ResponseError{Code: StatusServerTimeout, Message: "server timeout"}
+	ErrServerSerializationError  = ResponseError{Code: StatusServerSerializationError, Message: "result could not be serialized"}
+)
+
+// TypeMismatchError is returned by the error-returning ValuePair accessors, such as Int64 and Vertex, when the ValuePair
+// holds a type that can't be read as the one asked for. Unmarshal and the generic helpers return it too, naming the
+// field that couldn't be filled.
+type TypeMismatchError struct {
+	Expected ValueType
+	Actual   ValueType
+	Field    string
+}
+
+// Error satisfies the error interface
+func (e *TypeMismatchError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("type mismatch: expected %v, got %v: field: %s", e.Expected, e.Actual, e.Field)
+	}
+
+	return fmt.Sprintf("type mismatch: expected %v, got %v", e.Expected, e.Actual)
+}
@@ -0,0 +1,48 @@
+package graphson
+
+// TraversalExplanationRecord mirrors the TraversalExplanation record returned by the explain() step. Each traversal is
+// represented by the string form of its steps.
+type TraversalExplanationRecord struct {
+	Original     []string                    `json:"original"`
+	Intermediate []StrategyApplicationRecord `json:"intermediate"`
+	Final        []string                    `json:"final"`
+}
+
+// StrategyApplicationRecord is a single entry of a TraversalExplanationRecord's Intermediate section, the traversal as it
+// stood after Strategy was applied.
+type StrategyApplicationRecord struct {
+	Strategy  string   `json:"strategy"`
+	Category  string   `json:"category"`
+	Traversal []string `json:"traversal"`
+}
+
+// Rewrites returns only the strategy applications that changed the traversal, each compared against the traversal
+// left by the application before it.
+func (t TraversalExplanationRecord) Rewrites() []StrategyApplicationRecord {
+	out := []StrategyApplicationRecord{}
+	previous := t.Original
+
+	for _, application := range t.Intermediate {
+		if !equalSteps(previous, application.Traversal) {
+			out = append(out, application)
+		}
+
+		previous = application.Traversal
+	}
+
+	return out
+}
+
+func equalSteps(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
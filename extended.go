@@ -0,0 +1,189 @@
+package graphson
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The records below hold the calendar types of the GraphSON extended (gx:) module that have no time.Time equivalent.
+// Their String methods return the ISO-8601 form Java writes them in.
+
+// LocalDateRecord mirrors gx:LocalDate, a date without a time or time zone.
+type LocalDateRecord struct {
+	Year  int        `json:"year"`
+	Month time.Month `json:"month"`
+	Day   int        `json:"day"`
+}
+
+func (d LocalDateRecord) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// LocalTimeRecord mirrors gx:LocalTime, a time of day without a date or time zone.
+type LocalTimeRecord struct {
+	Hour       int `json:"hour"`
+	Minute     int `json:"minute"`
+	Second     int `json:"second"`
+	Nanosecond int `json:"nanosecond"`
+}
+
+func (t LocalTimeRecord) String() string {
+	out := fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+
+	if t.Second == 0 && t.Nanosecond == 0 {
+		return out
+	}
+
+	out += fmt.Sprintf(":%02d", t.Second)
+
+	// like Java, write fractions of a second in groups of three digits
+	switch {
+	case t.Nanosecond == 0:
+	case t.Nanosecond%int(time.Millisecond) == 0:
+		out += fmt.Sprintf(".%03d", t.Nanosecond/int(time.Millisecond))
+	case t.Nanosecond%int(time.Microsecond) == 0:
+		out += fmt.Sprintf(".%06d", t.Nanosecond/int(time.Microsecond))
+	default:
+		out += fmt.Sprintf(".%09d", t.Nanosecond)
+	}
+
+	return out
+}
+
+// LocalDateTimeRecord mirrors gx:LocalDateTime, a date and time without a time zone.
+type LocalDateTimeRecord struct {
+	Date LocalDateRecord `json:"date"`
+	Time LocalTimeRecord `json:"time"`
+}
+
+func (dt LocalDateTimeRecord) String() string {
+	return dt.Date.String() + "T" + dt.Time.String()
+}
+
+// MonthDayRecord mirrors gx:MonthDay, a recurring day of the year.
+type MonthDayRecord struct {
+	Month time.Month `json:"month"`
+	Day   int        `json:"day"`
+}
+
+func (md MonthDayRecord) String() string {
+	return fmt.Sprintf("--%02d-%02d", int(md.Month), md.Day)
+}
+
+// YearMonthRecord mirrors gx:YearMonth.
+type YearMonthRecord struct {
+	Year  int        `json:"year"`
+	Month time.Month `json:"month"`
+}
+
+func (ym YearMonthRecord) String() string {
+	return fmt.Sprintf("%04d-%02d", ym.Year, int(ym.Month))
+}
+
+// PeriodRecord mirrors gx:Period, a date based amount of time.
+type PeriodRecord struct {
+	Years  int `json:"years"`
+	Months int `json:"months"`
+	Days   int `json:"days"`
+}
+
+func (p PeriodRecord) String() string {
+	if p.Years == 0 && p.Months == 0 && p.Days == 0 {
+		return "P0D"
+	}
+
+	out := "P"
+	if p.Years != 0 {
+		out += fmt.Sprintf("%dY", p.Years)
+	}
+
+	if p.Months != 0 {
+		out += fmt.Sprintf("%dM", p.Months)
+	}
+
+	if p.Days != 0 {
+		out += fmt.Sprintf("%dD", p.Days)
+	}
+
+	return out
+}
+
+// ZoneOffsetRecord mirrors gx:ZoneOffset, a fixed offset from UTC.
+type ZoneOffsetRecord struct {
+	Seconds int `json:"seconds"`
+}
+
+func (z ZoneOffsetRecord) String() string {
+	if z.Seconds == 0 {
+		return "Z"
+	}
+
+	sign, seconds := "+", z.Seconds
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+
+	out := fmt.Sprintf("%s%02d:%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		out += fmt.Sprintf(":%02d", seconds%60)
+	}
+
+	return out
+}
+
+// Location returns a fixed time.Location for the offset.
+func (z ZoneOffsetRecord) Location() *time.Location {
+	if z.Seconds == 0 {
+		return time.UTC
+	}
+
+	return time.FixedZone(z.String(), z.Seconds)
+}
+
+// OffsetTimeRecord mirrors gx:OffsetTime, a time of day with an offset from UTC but no date.
+type OffsetTimeRecord struct {
+	Time   LocalTimeRecord  `json:"time"`
+	Offset ZoneOffsetRecord `json:"offset"`
+}
+
+func (ot OffsetTimeRecord) String() string {
+	return ot.Time.String() + ot.Offset.String()
+}
+
+// FormatDuration writes a time.Duration in the ISO-8601 form Java's Duration.toString uses, e.g. PT8H6M12.345S.
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	hours := d / time.Hour
+	minutes := d % time.Hour / time.Minute
+	rest := d % time.Minute
+
+	out := "PT"
+	if hours != 0 {
+		out += fmt.Sprintf("%dH", hours)
+	}
+
+	if minutes != 0 {
+		out += fmt.Sprintf("%dM", minutes)
+	}
+
+	if rest != 0 {
+		sign := ""
+		if rest < 0 {
+			sign, rest = "-", -rest
+		}
+
+		out += fmt.Sprintf("%s%d", sign, rest/time.Second)
+
+		if nanos := rest % time.Second; nanos != 0 {
+			out += "." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+		}
+
+		out += "S"
+	}
+
+	return out
+}
@@ -0,0 +1,69 @@
+package graphson
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// As converts a ValuePair in to T following the rules of Unmarshal, so T may be anything from an int64 to a tagged
+// struct or a []map[string]int.
+func As[T any](vp ValuePair) (T, error) {
+	var out T
+	err := unmarshalValue(vp, reflect.ValueOf(&out).Elem(), "")
+
+	return out, err
+}
+
+// ListOf converts each element of a g:List, g:Set or g:BulkSet in to T, as As would. A ValuePair holding any other type
+// returns a *TypeMismatchError.
+func ListOf[T any](vp ValuePair) ([]T, error) {
+	values, err := vp.List()
+	if err != nil {
+		if values, err = vp.Set(); err != nil {
+			return nil, vp.mismatch(List)
+		}
+	}
+
+	out := make([]T, len(values))
+
+	for i, value := range values {
+		if err := unmarshalValue(value, reflect.ValueOf(&out[i]).Elem(), fmt.Sprintf("[%d]", i)); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// MapOf converts each entry of a g:Map in to a K key and V value, as As would. Later duplicate keys overwrite earlier
+// ones. A ValuePair holding any other type returns a *TypeMismatchError, and a key that can't be stored in a Go map,
+// such as a vertex read in to an interface, a ParsingError.
+func MapOf[K comparable, V any](vp ValuePair) (map[K]V, error) {
+	m, err := vp.Map()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[K]V, len(m.Entries))
+
+	for _, entry := range m.Entries {
+		var key K
+		if err := unmarshalValue(entry.Key, reflect.ValueOf(&key).Elem(), ""); err != nil {
+			return nil, err
+		}
+
+		// an interface K may be handed a key, such as a vertex, that isn't comparable
+		if err := mapKey(reflect.ValueOf(&key).Elem(), ""); err != nil {
+			return nil, err
+		}
+
+		var value V
+		if err := unmarshalValue(entry.Value, reflect.ValueOf(&value).Elem(), fmt.Sprint(key)); err != nil {
+			return nil, err
+		}
+
+		out[key] = value
+	}
+
+	return out, nil
+}
@@ -0,0 +1,94 @@
+package graphson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAs(t *testing.T) {
+	vp := testVertex()
+
+	named, err := As[testNamed](vp)
+	assert.Nil(t, err)
+	assert.Equal(t, testNamed{Label: "person", Name: "marko"}, named)
+
+	vertex, err := As[VertexRecord](vp)
+	assert.Nil(t, err)
+	assert.Equal(t, vp.AsVertex(), vertex)
+
+	n, err := As[int64](ValuePair{Type: Int32, Value: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), n)
+
+	_, err = As[int](vp)
+	assert.NotNil(t, err)
+}
+
+func TestListOf(t *testing.T) {
+	marko := ValuePair{Type: String, Value: "marko"}
+	josh := ValuePair{Type: String, Value: "josh"}
+
+	vp := ValuePair{Type: BulkSet, Value: BulkSetRecord{Entries: []BulkEntry{{Value: marko, Bulk: 1}, {Value: josh, Bulk: 2}}}}
+
+	names, err := ListOf[string](vp)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"marko", "josh", "josh"}, names)
+
+	vp = ValuePair{Type: List, Value: []ValuePair{
+		{Type: List, Value: []ValuePair{{Type: Int32, Value: 1}, {Type: Int32, Value: 2}}},
+		{Type: Set, Value: []ValuePair{{Type: Int64, Value: int64(3)}}},
+	}}
+
+	nested, err := ListOf[[]int64](vp)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int64{{1, 2}, {3}}, nested)
+
+	vp = ValuePair{Type: Set, Value: []ValuePair{{Type: Int32, Value: 1}, marko}}
+
+	_, err = ListOf[int](vp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "[1]", err.(*TypeMismatchError).Field)
+
+	_, err = ListOf[string](marko)
+	var mismatch *TypeMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, List, mismatch.Expected)
+}
+
+func TestMapOf(t *testing.T) {
+	double := func(f float64) ValuePair { return ValuePair{Type: Double, Value: f} }
+
+	vp := ValuePair{Type: Map, Value: MapRecord{Entries: []MapEntry{
+		{Key: ValuePair{Type: String, Value: "marko"}, Value: ValuePair{Type: List, Value: []ValuePair{double(1.5)}}},
+		{Key: ValuePair{Type: String, Value: "josh"}, Value: ValuePair{Type: List, Value: []ValuePair{double(2), double(3)}}},
+	}}}
+
+	scores, err := MapOf[string, []float64](vp)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]float64{"marko": {1.5}, "josh": {2, 3}}, scores)
+
+	_, err = MapOf[string, bool](vp)
+	assert.NotNil(t, err)
+
+	_, err = MapOf[string, int](ValuePair{Type: List, Value: []ValuePair{}})
+	var mismatch *TypeMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, Map, mismatch.Expected)
+}
+
+func TestMapOfUnhashableKey(t *testing.T) {
+	vp := ValuePair{Type: Map, Value: MapRecord{Entries: []MapEntry{
+		{Key: testVertex(), Value: ValuePair{Type: Int64, Value: int64(1)}},
+	}}}
+
+	assert.NotPanics(t, func() {
+		_, err := MapOf[any, int64](vp)
+		assert.NotNil(t, err, "a vertex can't key a Go map")
+	})
+
+	m, err := MapOf[testNamed, int64](vp)
+	assert.Nil(t, err)
+	assert.Equal(t, map[testNamed]int64{{Label: "person", Name: "marko"}: 1}, m)
+}
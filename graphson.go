@@ -13,12 +13,16 @@
 // limitations under the License.
 
 // Package graphson provides a parser for GraphSON structured json data. This interface is primarily consumed by a
-// Gremlin Go language variant. It provides a GraphSON version agnostic interface to the user, though only GraphSON 3.0+ is
-// currently covered. Visit http://tinkerpop.apache.org/docs/3.4.2/dev/io/#graphson-3d0 for more information. This is not
+// Gremlin Go language variant. It provides a GraphSON version agnostic interface to the user, covering GraphSON 1.0 ("v1"),
+// GraphSON 2.0 ("v2") and GraphSON 3.0+ ("v3"). Visit http://tinkerpop.apache.org/docs/3.4.2/dev/io/#graphson-3d0 for more information. This is not
 // intended to handle things like
 package graphson
 
 import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
 	"sync"
 	"time"
 )
@@ -26,6 +30,12 @@ import (
 var (
 	parsersMu sync.RWMutex
 	parsers   = make(map[string]GraphSONParser)
+
+	serializersMu sync.RWMutex
+	serializers   = make(map[string]GraphSONSerializer)
+
+	valueTypesMu  sync.Mutex
+	nextValueType = Unknown + 1
 )
 
 // RegisterParser allows an outside package to register a GraphSONParser compatible type with this package.
@@ -44,6 +54,25 @@ func NewParser(parserVersion string) GraphSONParser {
 	return parsers[parserVersion]
 }
 
+// RegisterSerializer allows an outside package to register a GraphSONSerializer compatible type with this package.
+func RegisterSerializer(name string, serializer GraphSONSerializer) {
+	serializersMu.Lock()
+	defer serializersMu.Unlock()
+
+	if serializer == nil {
+		panic("GraphSONSerializer is nil for provider " + name)
+	}
+
+	serializers[name] = serializer
+}
+
+func NewSerializer(serializerVersion string) GraphSONSerializer {
+	serializersMu.RLock()
+	defer serializersMu.RUnlock()
+
+	return serializers[serializerVersion]
+}
+
 // ValueType represents the GraphSON equivalent type of a value in a ValuePair type.
 type ValueType int
 
@@ -52,8 +81,8 @@ const (
 	Boolean
 	Class
 	Date
-	Double
-	Float
+	Double // a float64, it held a float32 before the typed accessors were added
+	Float  // a float32, it held a float64 before the typed accessors were added
 	Int64
 	Int32
 	List
@@ -65,12 +94,130 @@ const (
 	VertexProperty
 	Edge
 	EdgeProperty
+	Path
+	Tree
+	BulkSet
+	Traverser
+	TraversalMetrics
+	Metrics
+	TraversalExplanation
+	T
+	Direction
+	Cardinality
+	Order
+	Pop
+	Scope
+	Column
+	Barrier
+	Operator
+	Pick
+	BigDecimal
+	BigInteger
+	Byte
+	ByteBuffer
+	Char
+	Duration
+	Instant
+	LocalDate
+	LocalDateTime
+	LocalTime
+	MonthDay
+	OffsetDateTime
+	OffsetTime
+	Period
+	Short
+	Year
+	YearMonth
+	ZonedDateTime
+	ZoneOffset
 	Unknown
 )
 
+// NewValueType reserves a ValueType that doesn't collide with those declared by this package or reserved before, for use
+// by types a graph provider layers on top of GraphSON.
+func NewValueType() ValueType {
+	valueTypesMu.Lock()
+	defer valueTypesMu.Unlock()
+
+	vt := nextValueType
+	nextValueType++
+
+	return vt
+}
+
+var valueTypeNames = [...]string{
+	String:               "String",
+	Boolean:              "Boolean",
+	Class:                "Class",
+	Date:                 "Date",
+	Double:               "Double",
+	Float:                "Float",
+	Int64:                "Int64",
+	Int32:                "Int32",
+	List:                 "List",
+	Map:                  "Map",
+	Timestamp:            "Timestamp",
+	Set:                  "Set",
+	UUID:                 "UUID",
+	Vertex:               "Vertex",
+	VertexProperty:       "VertexProperty",
+	Edge:                 "Edge",
+	EdgeProperty:         "EdgeProperty",
+	Path:                 "Path",
+	Tree:                 "Tree",
+	BulkSet:              "BulkSet",
+	Traverser:            "Traverser",
+	TraversalMetrics:     "TraversalMetrics",
+	Metrics:              "Metrics",
+	TraversalExplanation: "TraversalExplanation",
+	T:                    "T",
+	Direction:            "Direction",
+	Cardinality:          "Cardinality",
+	Order:                "Order",
+	Pop:                  "Pop",
+	Scope:                "Scope",
+	Column:               "Column",
+	Barrier:              "Barrier",
+	Operator:             "Operator",
+	Pick:                 "Pick",
+	BigDecimal:           "BigDecimal",
+	BigInteger:           "BigInteger",
+	Byte:                 "Byte",
+	ByteBuffer:           "ByteBuffer",
+	Char:                 "Char",
+	Duration:             "Duration",
+	Instant:              "Instant",
+	LocalDate:            "LocalDate",
+	LocalDateTime:        "LocalDateTime",
+	LocalTime:            "LocalTime",
+	MonthDay:             "MonthDay",
+	OffsetDateTime:       "OffsetDateTime",
+	OffsetTime:           "OffsetTime",
+	Period:               "Period",
+	Short:                "Short",
+	Year:                 "Year",
+	YearMonth:            "YearMonth",
+	ZonedDateTime:        "ZonedDateTime",
+	ZoneOffset:           "ZoneOffset",
+	Unknown:              "Unknown",
+}
+
+// String returns the name of the ValueType, or the @type name of a type added with RegisterType.
+func (vt ValueType) String() string {
+	if vt >= 0 && int(vt) < len(valueTypeNames) {
+		return valueTypeNames[vt]
+	}
+
+	if name, _, ok := LookupTypeEncoder(vt); ok {
+		return name
+	}
+
+	return fmt.Sprintf("ValueType(%d)", int(vt))
+}
+
 // GraphSONParser enforces a standard set of functions that a GraphSON parser must satisfy. It is up to the individual
 // implementer to handle the parsing of any data types apart from Vertex, Vertex Property, Edge, and Property.
-// *Note:* TinkerGraph and Path are absent from this list and interface as we believe they're considered legacy types.
+// *Note:* TinkerGraph is absent from this list and interface as we believe it's considered a legacy type.
 type GraphSONParser interface {
 	Parse(in []byte) (ValuePair, error)
 	ParseVertex(in []byte) (VertexRecord, error)
@@ -78,34 +225,149 @@ type GraphSONParser interface {
 	ParseVertexProperty(in []byte) (VertexPropertyRecord, error)
 	ParseEdge(in []byte) (EdgeRecord, error)
 	ParseProperty(in []byte) (Property, error)
+	ParseResponse(in []byte) (ResponseMessage, error)
+}
+
+// GraphSONSerializer is the inverse of GraphSONParser, writing the records and ValuePair types produced by a parser back
+// out as GraphSON. Serializing the output of the same version's parser should produce a document that parses back to
+// an identical result.
+type GraphSONSerializer interface {
+	Serialize(in ValuePair) ([]byte, error)
+	SerializeVertex(in VertexRecord) ([]byte, error)
+	SerializeVertexProperty(in VertexPropertyRecord) ([]byte, error)
+	SerializeEdge(in EdgeRecord) ([]byte, error)
+	SerializeProperty(in Property) ([]byte, error)
+	SerializeRequest(in RequestMessage) ([]byte, error)
 }
 
 // VertexRecord mirrors the basic Vertex record structure defined by GraphSON and Gremlin.
 type VertexRecord struct {
-	ID         interface{}                       `json:"id"` // ID as interface{}, different providers use different ID types
+	ID         ValuePair                         `json:"id"` // IDs keep their GraphSON type, different providers use different ID types
 	Label      string                            `json:"label"`
 	Properties map[string][]VertexPropertyRecord `json:"properties"`
 }
 
 // VertexPropertyRecord mirrors the basic VertexRecord Property structure defined by GraphSON and Gremlin.
 type VertexPropertyRecord struct {
-	ID         interface{}          `json:"id"` // ID as interface{}, different providers use different ID types
-	Value      string               `json:"value"`
+	ID         ValuePair            `json:"id"` // IDs keep their GraphSON type, different providers use different ID types
+	Value      ValuePair            `json:"value"`
 	Label      string               `json:"label"`
 	Properties map[string]ValuePair `json:"properties"`
 }
 
+// ValueString returns the property's value as a string, easing the move from when Value was always a string. String
+// values are returned as they are and anything else is formatted with fmt.
+func (p VertexPropertyRecord) ValueString() string {
+	switch value := p.Value.Value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
 // EdgeRecord mirrors the basic Edge record defined by GraphSON and Gremlin.
 type EdgeRecord struct {
-	ID         interface{}         `json:"id"` // ID as interface{}, different providers use different ID types
+	ID         ValuePair           `json:"id"` // IDs keep their GraphSON type, different providers use different ID types
 	Label      string              `json:"label"`
 	InVLabel   string              `json:"inVLabel"`
 	OutVLabel  string              `json:"outVLabel"`
-	InV        interface{}         `json:"inV"`
-	OutV       interface{}         `json:"outV"`
+	InV        ValuePair           `json:"inV"`
+	OutV       ValuePair           `json:"outV"`
 	Properties map[string]Property `json:"properties"`
 }
 
+// PathRecord mirrors the Path record defined by GraphSON and Gremlin. Labels and Objects are parallel, Labels[i] holding
+// the step labels (if any) applied to Objects[i].
+type PathRecord struct {
+	Labels  [][]string  `json:"labels"`
+	Objects []ValuePair `json:"objects"`
+}
+
+// Get returns the first object in the path labeled with the given step label.
+func (p PathRecord) Get(label string) (ValuePair, bool) {
+	for i, labels := range p.Labels {
+		for _, l := range labels {
+			if l == label && i < len(p.Objects) {
+				return p.Objects[i], true
+			}
+		}
+	}
+
+	return ValuePair{}, false
+}
+
+// BulkSetRecord mirrors the BulkSet record returned by Gremlin Server when bulking is enabled, each distinct value
+// being sent once along with the number of times it occurs.
+type BulkSetRecord struct {
+	Entries []BulkEntry `json:"entries"`
+}
+
+// BulkEntry is a single value in a BulkSetRecord and the number of times it occurs.
+type BulkEntry struct {
+	Value ValuePair `json:"value"`
+	Bulk  int64     `json:"bulk"`
+}
+
+// Len returns the number of values the BulkSetRecord represents once expanded. Negative bulks, which the parser
+// rejects, count as zero and the total stops at math.MaxInt64 rather than overflowing.
+func (b BulkSetRecord) Len() int64 {
+	var total int64
+
+	for _, entry := range b.Entries {
+		if entry.Bulk <= 0 {
+			continue
+		}
+
+		if total > math.MaxInt64-entry.Bulk {
+			return math.MaxInt64
+		}
+
+		total += entry.Bulk
+	}
+
+	return total
+}
+
+// MaxExpandedBulk is the most values Expand, or the GraphSON 3 parser's UnwrapTraversers option, will produce from a
+// single result. A bulk is a count rather than a size, so a larger one is rejected instead of filling memory with copies
+// of the same value.
+const MaxExpandedBulk = 1 << 20
+
+// Expand returns every value in the BulkSetRecord, each repeated as many times as its bulk. A negative bulk, or bulks
+// adding up to more than MaxExpandedBulk, return an error instead.
+func (b BulkSetRecord) Expand() ([]ValuePair, error) {
+	for _, entry := range b.Entries {
+		if entry.Bulk < 0 {
+			return nil, ParsingError{Message: fmt.Sprintf("bulk %d must not be negative", entry.Bulk), Operation: "expand", Field: "bulk"}
+		}
+	}
+
+	total := b.Len()
+	if total > MaxExpandedBulk {
+		return nil, ParsingError{Message: fmt.Sprintf("bulk of %d exceeds MaxExpandedBulk", total), Operation: "expand", Field: "bulk"}
+	}
+
+	out := make([]ValuePair, 0, total)
+
+	for _, entry := range b.Entries {
+		for i := int64(0); i < entry.Bulk; i++ {
+			out = append(out, entry.Value)
+		}
+	}
+
+	return out, nil
+}
+
+// TraverserRecord mirrors the Traverser record found in the results of bytecode requests, Bulk being the number of
+// traversers the single Value stands in for.
+type TraverserRecord struct {
+	Bulk  int64     `json:"bulk"`
+	Value ValuePair `json:"value"`
+}
+
 // Property reflects a common GraphSON pattern of Key - Type Value data representation.
 type Property struct {
 	Key   string    `json:"key"`
@@ -120,6 +382,12 @@ type ValuePair struct {
 	Value interface{}
 }
 
+// Equal reports whether two ValuePairs hold the same type and value, such as two element IDs. Values are compared deeply
+// so that IDs of a provider's own type can be compared too.
+func (vp ValuePair) Equal(other ValuePair) bool {
+	return vp.Type == other.Type && reflect.DeepEqual(vp.Value, other.Value)
+}
+
 func (vp ValuePair) AsVertex() VertexRecord {
 	if vp.Type != Vertex {
 		return VertexRecord{}
@@ -152,7 +420,150 @@ func (vp ValuePair) AsProperty() Property {
 	return vp.Value.(Property)
 }
 
+func (vp ValuePair) AsPath() PathRecord {
+	if vp.Type != Path {
+		return PathRecord{}
+	}
+
+	return vp.Value.(PathRecord)
+}
+
+func (vp ValuePair) AsTree() TreeRecord {
+	if vp.Type != Tree {
+		return TreeRecord{}
+	}
+
+	return vp.Value.(TreeRecord)
+}
+
+func (vp ValuePair) AsBulkSet() BulkSetRecord {
+	if vp.Type != BulkSet {
+		return BulkSetRecord{}
+	}
+
+	return vp.Value.(BulkSetRecord)
+}
+
+func (vp ValuePair) AsTraverser() TraverserRecord {
+	if vp.Type != Traverser {
+		return TraverserRecord{}
+	}
+
+	return vp.Value.(TraverserRecord)
+}
+
+func (vp ValuePair) AsTraversalMetrics() TraversalMetricsRecord {
+	if vp.Type != TraversalMetrics {
+		return TraversalMetricsRecord{}
+	}
+
+	return vp.Value.(TraversalMetricsRecord)
+}
+
+func (vp ValuePair) AsMetrics() MetricsRecord {
+	if vp.Type != Metrics {
+		return MetricsRecord{}
+	}
+
+	return vp.Value.(MetricsRecord)
+}
+
+func (vp ValuePair) AsTraversalExplanation() TraversalExplanationRecord {
+	if vp.Type != TraversalExplanation {
+		return TraversalExplanationRecord{}
+	}
+
+	return vp.Value.(TraversalExplanationRecord)
+}
+
+func (vp ValuePair) AsT() TToken {
+	if vp.Type != T {
+		return ""
+	}
+
+	return vp.Value.(TToken)
+}
+
+func (vp ValuePair) AsDirection() DirectionToken {
+	if vp.Type != Direction {
+		return ""
+	}
+
+	return vp.Value.(DirectionToken)
+}
+
+func (vp ValuePair) AsCardinality() CardinalityToken {
+	if vp.Type != Cardinality {
+		return ""
+	}
+
+	return vp.Value.(CardinalityToken)
+}
+
+func (vp ValuePair) AsOrder() OrderToken {
+	if vp.Type != Order {
+		return ""
+	}
+
+	return vp.Value.(OrderToken)
+}
+
+func (vp ValuePair) AsPop() PopToken {
+	if vp.Type != Pop {
+		return ""
+	}
+
+	return vp.Value.(PopToken)
+}
+
+func (vp ValuePair) AsScope() ScopeToken {
+	if vp.Type != Scope {
+		return ""
+	}
+
+	return vp.Value.(ScopeToken)
+}
+
+func (vp ValuePair) AsColumn() ColumnToken {
+	if vp.Type != Column {
+		return ""
+	}
+
+	return vp.Value.(ColumnToken)
+}
+
+func (vp ValuePair) AsBarrier() BarrierToken {
+	if vp.Type != Barrier {
+		return ""
+	}
+
+	return vp.Value.(BarrierToken)
+}
+
+func (vp ValuePair) AsOperator() OperatorToken {
+	if vp.Type != Operator {
+		return ""
+	}
+
+	return vp.Value.(OperatorToken)
+}
+
+func (vp ValuePair) AsPick() PickToken {
+	if vp.Type != Pick {
+		return ""
+	}
+
+	return vp.Value.(PickToken)
+}
+
+// AsSet returns the elements of a g:Set. A g:BulkSet is expanded so that code written against unbulked results keeps
+// working when the server starts bulking them, or nil if it's too large to be, see Expand.
 func (vp ValuePair) AsSet() []ValuePair {
+	if vp.Type == BulkSet {
+		values, _ := vp.Value.(BulkSetRecord).Expand()
+		return values
+	}
+
 	if vp.Type != Set {
 		return nil
 	}
@@ -160,12 +571,32 @@ func (vp ValuePair) AsSet() []ValuePair {
 	return vp.Value.([]ValuePair)
 }
 
+func (vp ValuePair) AsMap() MapRecord {
+	if vp.Type != Map {
+		return MapRecord{}
+	}
+
+	return vp.Value.(MapRecord)
+}
+
+// AsFlatMap returns a g:Map as a Go map from each key's Value to the ValuePair stored under it. Keys that Go cannot hash,
+// such as vertices and lists, are left out and later duplicate keys overwrite earlier ones. Use AsMap when that matters.
 func (vp ValuePair) AsFlatMap() map[interface{}]interface{} {
 	if vp.Type != Map {
 		return nil
 	}
 
-	return vp.Value.(map[interface{}]interface{})
+	out := map[interface{}]interface{}{}
+
+	for _, entry := range vp.Value.(MapRecord).Entries {
+		if entry.Key.Value == nil || !hashable(reflect.ValueOf(entry.Key.Value)) {
+			continue
+		}
+
+		out[entry.Key.Value] = entry.Value
+	}
+
+	return out
 }
 
 func (vp ValuePair) AsString() string {
@@ -192,16 +623,18 @@ func (vp ValuePair) AsInt64() int64 {
 	return vp.Value.(int64)
 }
 
+// AsFloat32 returns the float32 held by a Float, see Float32 for an accessor that reports a mismatch.
 func (vp ValuePair) AsFloat32() float32 {
-	if vp.Type != Double {
+	if vp.Type != Float {
 		return 0
 	}
 
 	return vp.Value.(float32)
 }
 
+// AsFloat64 returns the float64 held by a Double, see Float64 for an accessor that also reads a Float.
 func (vp ValuePair) AsFloat64() float64 {
-	if vp.Type != Float {
+	if vp.Type != Double {
 		return 0
 	}
 
@@ -230,3 +663,155 @@ func (vp ValuePair) AsUUID() string {
 
 	return vp.Value.(string)
 }
+
+func (vp ValuePair) AsBigDecimal() *big.Float {
+	if vp.Type != BigDecimal {
+		return nil
+	}
+
+	return vp.Value.(*big.Float)
+}
+
+func (vp ValuePair) AsBigInteger() *big.Int {
+	if vp.Type != BigInteger {
+		return nil
+	}
+
+	return vp.Value.(*big.Int)
+}
+
+func (vp ValuePair) AsByte() int8 {
+	if vp.Type != Byte {
+		return 0
+	}
+
+	return vp.Value.(int8)
+}
+
+func (vp ValuePair) AsByteBuffer() []byte {
+	if vp.Type != ByteBuffer {
+		return nil
+	}
+
+	return vp.Value.([]byte)
+}
+
+func (vp ValuePair) AsChar() rune {
+	if vp.Type != Char {
+		return 0
+	}
+
+	return vp.Value.(rune)
+}
+
+func (vp ValuePair) AsDuration() time.Duration {
+	if vp.Type != Duration {
+		return 0
+	}
+
+	return vp.Value.(time.Duration)
+}
+
+func (vp ValuePair) AsInstant() time.Time {
+	if vp.Type != Instant {
+		return time.Time{}
+	}
+
+	return vp.Value.(time.Time)
+}
+
+func (vp ValuePair) AsLocalDate() LocalDateRecord {
+	if vp.Type != LocalDate {
+		return LocalDateRecord{}
+	}
+
+	return vp.Value.(LocalDateRecord)
+}
+
+func (vp ValuePair) AsLocalDateTime() LocalDateTimeRecord {
+	if vp.Type != LocalDateTime {
+		return LocalDateTimeRecord{}
+	}
+
+	return vp.Value.(LocalDateTimeRecord)
+}
+
+func (vp ValuePair) AsLocalTime() LocalTimeRecord {
+	if vp.Type != LocalTime {
+		return LocalTimeRecord{}
+	}
+
+	return vp.Value.(LocalTimeRecord)
+}
+
+func (vp ValuePair) AsMonthDay() MonthDayRecord {
+	if vp.Type != MonthDay {
+		return MonthDayRecord{}
+	}
+
+	return vp.Value.(MonthDayRecord)
+}
+
+func (vp ValuePair) AsOffsetDateTime() time.Time {
+	if vp.Type != OffsetDateTime {
+		return time.Time{}
+	}
+
+	return vp.Value.(time.Time)
+}
+
+func (vp ValuePair) AsOffsetTime() OffsetTimeRecord {
+	if vp.Type != OffsetTime {
+		return OffsetTimeRecord{}
+	}
+
+	return vp.Value.(OffsetTimeRecord)
+}
+
+func (vp ValuePair) AsPeriod() PeriodRecord {
+	if vp.Type != Period {
+		return PeriodRecord{}
+	}
+
+	return vp.Value.(PeriodRecord)
+}
+
+func (vp ValuePair) AsShort() int16 {
+	if vp.Type != Short {
+		return 0
+	}
+
+	return vp.Value.(int16)
+}
+
+func (vp ValuePair) AsYear() int {
+	if vp.Type != Year {
+		return 0
+	}
+
+	return vp.Value.(int)
+}
+
+func (vp ValuePair) AsYearMonth() YearMonthRecord {
+	if vp.Type != YearMonth {
+		return YearMonthRecord{}
+	}
+
+	return vp.Value.(YearMonthRecord)
+}
+
+func (vp ValuePair) AsZonedDateTime() time.Time {
+	if vp.Type != ZonedDateTime {
+		return time.Time{}
+	}
+
+	return vp.Value.(time.Time)
+}
+
+func (vp ValuePair) AsZoneOffset() ZoneOffsetRecord {
+	if vp.Type != ZoneOffset {
+		return ZoneOffsetRecord{}
+	}
+
+	return vp.Value.(ZoneOffsetRecord)
+}
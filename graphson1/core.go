@@ -0,0 +1,149 @@
+package graphson1
+
+import (
+	"github.com/buger/jsonparser"
+	"github.com/dnoberon/graphson"
+)
+
+// Parse accepts any valid GraphSON 1 value and returns the parsed object. Additional operations can be used to discover type
+func (g GraphSONv1Parser) Parse(in []byte) (graphson.ValuePair, error) {
+	typeName, err := getValueType(in)
+	if err != nil {
+		return graphson.ValuePair{}, err
+	}
+
+	var out interface{}
+
+	switch typeName {
+	case graphson.Vertex:
+		out, err = g.ParseVertex(in)
+	case graphson.VertexProperty:
+		out, err = g.ParseVertexProperty(in)
+	case graphson.Edge:
+		out, err = g.ParseEdge(in)
+	case graphson.EdgeProperty:
+		out, err = g.ParseProperty(in)
+	case graphson.List:
+		out, err = g.parseList(in)
+	case graphson.Map:
+		out, err = g.parseMap(in)
+	case graphson.String:
+		out, err = jsonparser.ParseString(rawValue(in))
+	case graphson.Boolean:
+		out, err = jsonparser.ParseBoolean(rawValue(in))
+	case graphson.Int64:
+		out, err = jsonparser.ParseInt(rawValue(in))
+	case graphson.Double:
+		out, err = jsonparser.ParseFloat(rawValue(in))
+	}
+
+	return graphson.ValuePair{Type: typeName, Value: out}, err
+}
+
+// rawValue strips surrounding whitespace and, for strings, the enclosing quotes from a JSON value
+func rawValue(in []byte) []byte {
+	value, _, _, err := jsonparser.Get(in)
+	if err != nil {
+		return in
+	}
+
+	return value
+}
+
+// quote restores the quotes jsonparser strips from string values so they can be handed back to Parse
+func quote(in []byte) []byte {
+	out := make([]byte, 0, len(in)+2)
+	out = append(out, '"')
+	out = append(out, in...)
+
+	return append(out, '"')
+}
+
+func (g GraphSONv1Parser) parseList(in []byte) ([]graphson.ValuePair, error) {
+	out := []graphson.ValuePair{}
+
+	vt, err := getValueType(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if vt != graphson.List {
+		return nil, graphson.ParsingError{Message: "provided input not a JSON array", Operation: "parseList", Field: "value"}
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+	_, err = jsonparser.ArrayEach(in, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		currentError := graphson.ParsingError{Operation: "parseList", Field: "value"}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		if dataType == jsonparser.String {
+			value = quote(value)
+		}
+
+		vp, err := g.Parse(value)
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		out = append(out, vp)
+	})
+
+	if err != nil {
+		return out, graphson.ParsingError{Message: err.Error(), Operation: "parseList", Field: "value"}
+	}
+
+	return out, parsingErrors.Combine()
+}
+
+func (g GraphSONv1Parser) parseMap(in []byte) (graphson.MapRecord, error) {
+	out := graphson.MapRecord{Entries: []graphson.MapEntry{}}
+
+	vt, err := getValueType(in)
+	if err != nil {
+		return out, err
+	}
+
+	if vt != graphson.Map {
+		return out, graphson.ParsingError{Message: "provided input not a JSON object", Operation: "parseMap", Field: "value"}
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+	err = jsonparser.ObjectEach(in, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
+		currentError := graphson.ParsingError{Operation: "parseMap", Field: string(key)}
+
+		name, err := jsonparser.ParseString(key)
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return nil
+		}
+
+		if dataType == jsonparser.String {
+			value = quote(value)
+		}
+
+		vp, err := g.Parse(value)
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return nil
+		}
+
+		out.Entries = append(out.Entries, graphson.MapEntry{Key: graphson.ValuePair{Type: graphson.String, Value: name}, Value: vp})
+
+		return nil
+	})
+
+	if err != nil {
+		return out, graphson.ParsingError{Message: err.Error(), Operation: "parseMap", Field: "value"}
+	}
+
+	return out, parsingErrors.Combine()
+}
@@ -0,0 +1,81 @@
+package graphson1
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistered(t *testing.T) {
+	assert.IsType(t, GraphSONv1Parser{}, graphson.NewParser("v1"))
+}
+
+func TestValueTypeInference(t *testing.T) {
+	cases := map[string]graphson.ValueType{
+		`"marko"`:        graphson.String,
+		`true`:           graphson.Boolean,
+		`29`:             graphson.Int64,
+		`29.5`:           graphson.Double,
+		list10:           graphson.List,
+		map10:            graphson.Map,
+		vertex10:         graphson.Vertex,
+		vertexProperty10: graphson.VertexProperty,
+		edge10:           graphson.Edge,
+		property10:       graphson.EdgeProperty,
+	}
+
+	for in, expected := range cases {
+		vt, err := getValueType([]byte(in))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, vt, in)
+	}
+}
+
+func TestPropertyShapedMap(t *testing.T) {
+	g := GraphSONv1Parser{}
+
+	// a user map with exactly the keys of a vertex property can't be told apart from one
+	vp, err := g.Parse([]byte(`{"id":1,"value":2,"label":"x"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.VertexProperty, vp.Type)
+	assert.Equal(t, int64(2), vp.AsVertexProperty().Value.AsInt64())
+
+	vp, err = g.Parse([]byte(`{"key":"a","value":2}`))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.EdgeProperty, vp.Type)
+
+	// any other key makes it a map
+	vp, err = g.Parse([]byte(`{"id":1,"value":2,"label":"x","extra":true}`))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Map, vp.Type)
+	assert.Len(t, vp.AsMap().Entries, 4)
+}
+
+func TestListParse(t *testing.T) {
+	g := GraphSONv1Parser{}
+	list, err := g.parseList([]byte(list10))
+
+	assert.Nil(t, err)
+	assert.Len(t, list, 4)
+
+	assert.Equal(t, int64(1), list[0].AsInt64())
+	assert.Equal(t, "person", list[1].AsString())
+	assert.Equal(t, true, list[2].Value)
+	assert.Equal(t, 1.5, list[3].Value)
+}
+
+func TestMapParse(t *testing.T) {
+	g := GraphSONv1Parser{}
+	vp, err := g.Parse([]byte(map10))
+
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Map, vp.Type)
+
+	m := vp.AsFlatMap()
+	assert.Len(t, m, 2)
+
+	age := m["age"].(graphson.ValuePair)
+	assert.Equal(t, graphson.List, age.Type)
+	assert.Equal(t, int64(29), age.Value.([]graphson.ValuePair)[0].AsInt64())
+}
@@ -0,0 +1,189 @@
+package graphson1
+
+import (
+	"strings"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+const edgeTypeName = "edge"
+
+// ParseEdge expects the input to be valid JSON and to be a single Edge record. See either the testing file for sample
+// edge json records or http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_edge.
+func (g GraphSONv1Parser) ParseEdge(in []byte) (e graphson.EdgeRecord, err error) {
+	e.Properties = map[string]graphson.Property{}
+
+	if typeName, err := jsonparser.GetString(in, "type"); err != nil || typeName != edgeTypeName {
+		return e, graphson.ParsingError{Message: err, Operation: "parseEdge", Field: "type"}
+	}
+
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"id"},
+		{"label"},
+		{"inVLabel"},
+		{"outVLabel"},
+		{"inV"},
+		{"outV"},
+		{"properties"},
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		currentError := graphson.ParsingError{Operation: "parseEdge", Field: strings.Join(paths[idx], " ")}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		switch idx {
+		case 0: // id
+			id, err := g.elementID(value, vt)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.ID = id
+
+		case 1: // label
+			label, err := jsonparser.ParseString(value)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.Label = label
+
+		case 2: // inVLabel
+			label, err := jsonparser.ParseString(value)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.InVLabel = label
+
+		case 3: // outVLabel
+			label, err := jsonparser.ParseString(value)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.OutVLabel = label
+
+		case 4: // inV
+			v, err := g.elementID(value, vt)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.InV = v
+
+		case 5: // outV
+			v, err := g.elementID(value, vt)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.OutV = v
+
+		case 6: // properties, a plain key -> value object in GraphSON 1
+			err = jsonparser.ObjectEach(value, func(key []byte, prop []byte, dataType jsonparser.ValueType, offset int) error {
+				propertyName, err := jsonparser.ParseString(key)
+				if err != nil {
+					return err
+				}
+
+				if dataType == jsonparser.String {
+					prop = quote(prop)
+				}
+
+				val, err := g.Parse(prop)
+				if err != nil {
+					return err
+				}
+
+				e.Properties[propertyName] = graphson.Property{Key: propertyName, Value: val}
+
+				return nil
+			})
+
+			if err != nil {
+				currentError.Message = err.Error()
+			}
+		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+
+	}, paths...)
+
+	return e, parsingErrors.Combine()
+}
+
+// ParseProperty expects the input to be valid JSON and to be a single Property record. See either the testing file for sample
+// property json records or http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_property.
+func (g GraphSONv1Parser) ParseProperty(in []byte) (property graphson.Property, err error) {
+	if vt, err := getValueType(in); err != nil || vt != graphson.EdgeProperty {
+		return property, graphson.ParsingError{Message: "provided input not a key/value property", Operation: "parseProperty", Field: "value"}
+	}
+
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"key"},
+		{"value"},
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		currentError := graphson.ParsingError{Operation: "parseProperty", Field: strings.Join(paths[idx], " ")}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		switch idx {
+		case 0: // key
+			key, e := jsonparser.ParseString(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			property.Key = key
+
+		case 1: // value
+			if vt == jsonparser.String {
+				value = quote(value)
+			}
+
+			val, e := g.Parse(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			property.Value = val
+		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+
+	}, paths...)
+
+	return property, parsingErrors.Combine()
+}
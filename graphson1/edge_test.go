@@ -0,0 +1,32 @@
+package graphson1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEdge(t *testing.T) {
+	g := GraphSONv1Parser{}
+	edge, err := g.ParseEdge([]byte(edge10))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(13), edge.ID.AsInt64())
+	assert.Equal(t, "develops", edge.Label)
+
+	assert.Equal(t, "person", edge.OutVLabel)
+	assert.Equal(t, int64(1), edge.OutV.AsInt64())
+
+	assert.Equal(t, "software", edge.InVLabel)
+	assert.Equal(t, int64(10), edge.InV.AsInt64())
+
+	assert.Equal(t, "since", edge.Properties["since"].Key)
+	assert.Equal(t, int64(2009), edge.Properties["since"].Value.AsInt64())
+}
+
+func TestParseProperty(t *testing.T) {
+	g := GraphSONv1Parser{}
+	property, err := g.ParseProperty([]byte(property10))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2009), property.Value.AsInt64())
+	assert.Equal(t, "since", property.Key)
+}
@@ -0,0 +1,86 @@
+package graphson1
+
+import (
+	"math"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+// GraphSONv1Parser handles GraphSON 1.0, which carries no @type/@value envelopes. Every ValueType is inferred from the
+// shape of the raw JSON, so the numeric and temporal types GraphSON 2.0+ can express collapse in to Int64, Double and String.
+type GraphSONv1Parser struct{}
+
+// elementID parses an element's ID, keeping the type it was written with so that it can be sent back in a binding as it is
+func (g GraphSONv1Parser) elementID(value []byte, vt jsonparser.ValueType) (graphson.ValuePair, error) {
+	// jsonparser strips the quotes from string IDs, put them back so Parse sees valid JSON
+	if vt == jsonparser.String {
+		value = quote(value)
+	}
+
+	return g.Parse(value)
+}
+
+// getValueType examines a GraphSON 1 value and infers its type from the JSON shape alone. Vertices and edges are recognized
+// by their "type" field, properties by their exact set of keys, and any other object is treated as a map. GraphSON 1
+// doesn't tell the two apart, so a map whose keys are exactly "key" and "value", or "id", "value" and "label", is read
+// as a property wherever it appears; this keeps properties returned at the top level, e.g. by g.V().properties().
+func getValueType(in []byte) (graphson.ValueType, error) {
+	value, dt, _, err := jsonparser.Get(in)
+	if err != nil {
+		return graphson.Unknown, err
+	}
+
+	switch dt {
+	case jsonparser.String:
+		return graphson.String, nil
+	case jsonparser.Boolean:
+		return graphson.Boolean, nil
+	case jsonparser.Array:
+		return graphson.List, nil
+	case jsonparser.Number:
+		n, err := jsonparser.ParseFloat(value)
+		if err != nil {
+			return graphson.Unknown, err
+		}
+
+		if math.Trunc(n) == n {
+			return graphson.Int64, nil
+		}
+
+		return graphson.Double, nil
+	case jsonparser.Object:
+		return objectType(value), nil
+	}
+
+	return graphson.Unknown, nil
+}
+
+func objectType(in []byte) graphson.ValueType {
+	switch elementType, _ := jsonparser.GetString(in, "type"); elementType {
+	case vertexTypeName:
+		return graphson.Vertex
+	case edgeTypeName:
+		return graphson.Edge
+	}
+
+	keys := map[string]bool{}
+	_ = jsonparser.ObjectEach(in, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
+		keys[string(key)] = true
+		return nil
+	})
+
+	switch {
+	case len(keys) == 2 && keys["key"] && keys["value"]:
+		return graphson.EdgeProperty
+	case keys["id"] && keys["value"] && keys["label"] && (len(keys) == 3 || (len(keys) == 4 && keys["properties"])):
+		return graphson.VertexProperty
+	}
+
+	return graphson.Map
+}
+
+func init() {
+	graphson.RegisterParser("v1", GraphSONv1Parser{})
+}
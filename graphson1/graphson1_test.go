@@ -0,0 +1,68 @@
+package graphson1
+
+const vertex10 = `{
+  "id" : 1,
+  "label" : "person",
+  "type" : "vertex",
+  "outE" : {
+    "develops" : [ {
+      "id" : 10,
+      "inV" : 10,
+      "properties" : {
+        "since" : 2009
+      }
+    } ]
+  },
+  "properties" : {
+    "name" : [ {
+      "id" : 0,
+      "value" : "marko"
+    } ],
+    "location" : [ {
+      "id" : 6,
+      "value" : "san diego",
+      "properties" : {
+        "startTime" : 1997,
+        "endTime" : 2001
+      }
+    }, {
+      "id" : 7,
+      "value" : "santa cruz",
+      "properties" : {
+        "startTime" : 2001,
+        "endTime" : 2004
+      }
+    } ]
+  }
+}`
+
+const vertexProperty10 = `{
+  "id" : 0,
+  "value" : "marko",
+  "label" : "name"
+}`
+
+const edge10 = `{
+  "id" : 13,
+  "label" : "develops",
+  "type" : "edge",
+  "inVLabel" : "software",
+  "outVLabel" : "person",
+  "inV" : 10,
+  "outV" : 1,
+  "properties" : {
+    "since" : 2009
+  }
+}`
+
+const property10 = `{
+  "key" : "since",
+  "value" : 2009
+}`
+
+const list10 = `[ 1, "person", true, 1.5 ]`
+
+const map10 = `{
+  "name" : [ "marko" ],
+  "age" : [ 29 ]
+}`
@@ -0,0 +1,56 @@
+package graphson1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+const response10 = `{
+  "requestId" : "41d2e28a-20a4-4ab0-b379-d810dede3786",
+  "status" : {
+    "message" : "",
+    "code" : 200,
+    "attributes" : { }
+  },
+  "result" : {
+    "data" : [ 1, "marko" ],
+    "meta" : { }
+  }
+}`
+
+const timeoutResponse10 = `{
+  "requestId" : "41d2e28a-20a4-4ab0-b379-d810dede3786",
+  "status" : {
+    "message" : "A timeout occurred during traversal evaluation",
+    "code" : 598,
+    "attributes" : { }
+  },
+  "result" : {
+    "data" : null,
+    "meta" : { }
+  }
+}`
+
+func TestParseResponse(t *testing.T) {
+	g := GraphSONv1Parser{}
+	response, err := g.ParseResponse([]byte(response10))
+	assert.Nil(t, err)
+
+	assert.Equal(t, "41d2e28a-20a4-4ab0-b379-d810dede3786", response.RequestID)
+	assert.Equal(t, graphson.StatusSuccess, response.Status.Code)
+
+	data := response.Data.Value.([]graphson.ValuePair)
+	assert.Len(t, data, 2)
+	assert.Equal(t, int64(1), data[0].AsInt64())
+	assert.Equal(t, "marko", data[1].AsString())
+}
+
+func TestParseResponseError(t *testing.T) {
+	g := GraphSONv1Parser{}
+	_, err := g.ParseResponse([]byte(timeoutResponse10))
+
+	assert.True(t, errors.Is(err, graphson.ErrServerTimeout))
+}
@@ -0,0 +1,205 @@
+package graphson1
+
+import (
+	"strings"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+const vertexTypeName = "vertex"
+
+// ParseVertex expects the input to be valid JSON and to be a single Vertex record. See either the testing file for sample
+// vertex json records or http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_vertex.
+func (g GraphSONv1Parser) ParseVertex(in []byte) (v graphson.VertexRecord, err error) {
+	v.Properties = map[string][]graphson.VertexPropertyRecord{}
+
+	if typeName, err := jsonparser.GetString(in, "type"); err != nil || typeName != vertexTypeName {
+		return v, graphson.ParsingError{Message: err, Operation: "parseVertex", Field: "type"}
+	}
+
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"label"},
+		{"id"},
+		{"properties"},
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		var currentError = graphson.ParsingError{Operation: "parseVertex", Field: strings.Join(paths[idx], " ")}
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		switch idx {
+		case 0: // label
+			label, e := jsonparser.ParseString(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			v.Label = label
+
+		case 1: // id
+			id, e := g.elementID(value, vt)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			v.ID = id
+
+		case 2: // properties (VertexPropertyRecord)
+			e := jsonparser.ObjectEach(value, func(key []byte, prop []byte, dataType jsonparser.ValueType, offset int) error {
+				propertyName, e := jsonparser.ParseString(key)
+				if e != nil {
+					return e
+				}
+
+				parsedProperties, e := g.ParseVertexProperties(prop)
+				if e != nil {
+					return e
+				}
+
+				// vertex properties embedded in a vertex omit their label, it is implied by the property name
+				for i := range parsedProperties {
+					if parsedProperties[i].Label == "" {
+						parsedProperties[i].Label = propertyName
+					}
+				}
+
+				v.Properties[propertyName] = append(v.Properties[propertyName], parsedProperties...)
+
+				return nil
+			})
+
+			if e != nil {
+				currentError.Message = e.Error()
+			}
+		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+
+	}, paths...)
+
+	return v, parsingErrors.Combine()
+}
+
+func (g GraphSONv1Parser) ParseVertexProperties(in []byte) ([]graphson.VertexPropertyRecord, error) {
+	properties := []graphson.VertexPropertyRecord{}
+	parsingErrors := graphson.ParsingErrors{}
+
+	_, err := jsonparser.ArrayEach(in, func(prop []byte, dataType jsonparser.ValueType, offset int, err error) {
+		parsedProperty, e := g.ParseVertexProperty(prop)
+		if e != nil {
+			parsingErrors = append(parsingErrors, graphson.ParsingError{Message: e.Error(), Operation: "parseVertexProperties", Field: "properties"})
+			return
+		}
+
+		properties = append(properties, parsedProperty)
+	})
+
+	if err != nil {
+		return properties, graphson.ParsingError{Message: err.Error(), Operation: "parseVertexProperties", Field: "properties"}
+	}
+
+	return properties, parsingErrors.Combine()
+}
+
+// ParseVertexProperty expects the input to be valid JSON and to be a single Vertex Property record. See either the testing
+// file for sample vertex json records or http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_vertexproperty.
+func (g GraphSONv1Parser) ParseVertexProperty(in []byte) (property graphson.VertexPropertyRecord, err error) {
+	property.Properties = map[string]graphson.ValuePair{}
+
+	if _, dt, _, err := jsonparser.Get(in); err != nil || dt != jsonparser.Object {
+		return property, graphson.ParsingError{Message: "provided input not a JSON object", Operation: "parseVertexProperty", Field: "value"}
+	}
+
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"label"},
+		{"id"},
+		{"value"},
+		{"properties"},
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		currentError := graphson.ParsingError{Operation: "parseVertexProperty", Field: strings.Join(paths[idx], " ")}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		switch idx {
+		case 0: // label
+			label, e := jsonparser.ParseString(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			property.Label = label
+
+		case 1: // id
+			id, e := g.elementID(value, vt)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			property.ID = id
+
+		case 2: // value
+			if vt == jsonparser.String {
+				value = quote(value)
+			}
+
+			pValue, e := g.Parse(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			property.Value = pValue
+
+		case 3: // properties
+			e := jsonparser.ObjectEach(value, func(key []byte, prop []byte, dataType jsonparser.ValueType, offset int) error {
+				propertyName, e := jsonparser.ParseString(key)
+				if e != nil {
+					return e
+				}
+
+				if dataType == jsonparser.String {
+					prop = quote(prop)
+				}
+
+				property.Properties[propertyName], e = g.Parse(prop)
+
+				return e
+			})
+
+			if e != nil {
+				currentError.Message = e.Error()
+			}
+		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+
+	}, paths...)
+
+	return property, parsingErrors.Combine()
+}
@@ -0,0 +1,31 @@
+package graphson1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVertex(t *testing.T) {
+	g := GraphSONv1Parser{}
+	vertex, err := g.ParseVertex([]byte(vertex10))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), vertex.ID.AsInt64())
+	assert.Equal(t, "person", vertex.Label)
+
+	assert.Len(t, vertex.Properties["name"], 1)
+	assert.Len(t, vertex.Properties["location"], 2)
+	assert.Equal(t, "marko", vertex.Properties["name"][0].Value.AsString())
+	assert.Equal(t, "name", vertex.Properties["name"][0].Label)
+	assert.Equal(t, "san diego", vertex.Properties["location"][0].Value.AsString())
+	assert.Equal(t, int64(1997), vertex.Properties["location"][0].Properties["startTime"].AsInt64())
+}
+
+func TestParseVertexProperty(t *testing.T) {
+	g := GraphSONv1Parser{}
+	property, err := g.ParseVertexProperty([]byte(vertexProperty10))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), property.ID.AsInt64())
+	assert.Equal(t, "marko", property.Value.AsString())
+	assert.Equal(t, "name", property.Label)
+}
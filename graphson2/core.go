@@ -0,0 +1,278 @@
+package graphson2
+
+import (
+	"time"
+
+	"github.com/buger/jsonparser"
+	"github.com/dnoberon/graphson"
+)
+
+// Parse accepts a valid GraphSON 2 value, typed or plain JSON, and returns the parsed object. Additional operations can
+// be used to discover type
+func (g GraphSONv2Parser) Parse(in []byte) (graphson.ValuePair, error) {
+	typeName, err := getValueType(in)
+	if err != nil {
+		return graphson.ValuePair{}, err
+	}
+
+	var out interface{}
+
+	switch typeName {
+	case graphson.Vertex:
+		out, err = g.ParseVertex(in)
+	case graphson.VertexProperty:
+		out, err = g.ParseVertexProperty(in)
+	case graphson.Edge:
+		out, err = g.ParseEdge(in)
+	case graphson.EdgeProperty:
+		out, err = g.ParseProperty(in)
+	case graphson.List:
+		out, err = g.parseList(in)
+	case graphson.Map:
+		out, err = g.parseMap(in)
+	case graphson.Class:
+		out, err = g.parseClass(in)
+	case graphson.String:
+		out, err = jsonparser.ParseString(rawValue(in))
+	case graphson.Boolean:
+		out, err = jsonparser.ParseBoolean(rawValue(in))
+	case graphson.Int32:
+		out, err = g.parseInt32(in)
+	case graphson.Int64:
+		out, err = g.parseInt64(in)
+	case graphson.Double:
+		out, err = g.parseFloat64(in)
+	case graphson.Float:
+		out, err = g.parseFloat32(in)
+	case graphson.UUID:
+		out, err = g.parseUUID(in)
+	case graphson.Date:
+		out, err = g.parseTimestamp(in)
+	case graphson.Timestamp:
+		out, err = g.parseTimestamp(in)
+	}
+
+	return graphson.ValuePair{Type: typeName, Value: out}, err
+}
+
+// rawValue strips surrounding whitespace and, for strings, the enclosing quotes from a plain JSON value
+func rawValue(in []byte) []byte {
+	value, _, _, err := jsonparser.Get(in)
+	if err != nil {
+		return in
+	}
+
+	return value
+}
+
+// scalarValue returns the @value of a typed scalar, or the input itself when the scalar was written as plain JSON
+func scalarValue(in []byte) []byte {
+	value, _, _, err := jsonparser.Get(in, "@value")
+	if err != nil {
+		return rawValue(in)
+	}
+
+	return value
+}
+
+// parseList handles GraphSON 2 lists, which are plain JSON arrays of GraphSON 2 values
+func (g GraphSONv2Parser) parseList(in []byte) ([]graphson.ValuePair, error) {
+	out := []graphson.ValuePair{}
+
+	vt, err := getValueType(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if vt != graphson.List {
+		return nil, graphson.ParsingError{Message: "provided input not a JSON array", Operation: "parseList", Field: "@value"}
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+	_, err = jsonparser.ArrayEach(in, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		currentError := graphson.ParsingError{Operation: "parseList", Field: "@value"}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		// ArrayEach strips the quotes from string elements, put them back so Parse sees valid JSON
+		if dataType == jsonparser.String {
+			value = quote(value)
+		}
+
+		vp, err := g.Parse(value)
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		out = append(out, vp)
+	})
+
+	if err != nil {
+		return out, graphson.ParsingError{Message: err.Error(), Operation: "parseList", Field: "@value"}
+	}
+
+	return out, parsingErrors.Combine()
+}
+
+// parseMap handles GraphSON 2 maps, which are plain JSON objects. GraphSON 2 can only represent string keys.
+func (g GraphSONv2Parser) parseMap(in []byte) (graphson.MapRecord, error) {
+	out := graphson.MapRecord{Entries: []graphson.MapEntry{}}
+
+	vt, err := getValueType(in)
+	if err != nil {
+		return out, err
+	}
+
+	if vt != graphson.Map {
+		return out, graphson.ParsingError{Message: "provided input not a JSON object", Operation: "parseMap", Field: "@value"}
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+	err = jsonparser.ObjectEach(in, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
+		currentError := graphson.ParsingError{Operation: "parseMap", Field: string(key)}
+
+		name, err := jsonparser.ParseString(key)
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return nil
+		}
+
+		if dataType == jsonparser.String {
+			value = quote(value)
+		}
+
+		vp, err := g.Parse(value)
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return nil
+		}
+
+		out.Entries = append(out.Entries, graphson.MapEntry{Key: graphson.ValuePair{Type: graphson.String, Value: name}, Value: vp})
+
+		return nil
+	})
+
+	if err != nil {
+		return out, graphson.ParsingError{Message: err.Error(), Operation: "parseMap", Field: "@value"}
+	}
+
+	return out, parsingErrors.Combine()
+}
+
+func quote(in []byte) []byte {
+	out := make([]byte, 0, len(in)+2)
+	out = append(out, '"')
+	out = append(out, in...)
+
+	return append(out, '"')
+}
+
+func (g GraphSONv2Parser) parseInt32(in []byte) (int, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return 0, err
+	}
+
+	if vt != graphson.Int32 {
+		return 0, graphson.ParsingError{Message: "provided input not a g:Int32 type", Operation: "parseInt32", Field: "@type"}
+	}
+
+	value, err := jsonparser.ParseInt(scalarValue(in))
+
+	// There is a very real possibility that we unintentionally truncate the value if it is not really an int32
+	return int(value), err
+}
+
+func (g GraphSONv2Parser) parseInt64(in []byte) (int64, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return 0, err
+	}
+
+	if vt != graphson.Int64 {
+		return 0, graphson.ParsingError{Message: "provided input not a g:Int64 type", Operation: "parseInt64", Field: "@type"}
+	}
+
+	return jsonparser.ParseInt(scalarValue(in))
+}
+
+func (g GraphSONv2Parser) parseFloat32(in []byte) (float32, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return 0, err
+	}
+
+	if vt != graphson.Float {
+		return 0, graphson.ParsingError{Message: "provided input not a g:Float type", Operation: "parseFloat32", Field: "@type"}
+	}
+
+	value, err := jsonparser.ParseFloat(scalarValue(in))
+
+	return float32(value), err
+}
+
+func (g GraphSONv2Parser) parseFloat64(in []byte) (float64, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return 0, err
+	}
+
+	if vt != graphson.Double {
+		return 0, graphson.ParsingError{Message: "provided input not a g:Double type", Operation: "parseFloat64", Field: "@type"}
+	}
+
+	return jsonparser.ParseFloat(scalarValue(in))
+}
+
+// parseTimestamp handles both g:Timestamp and g:Date, each of which is a count of milliseconds since the unix epoch
+func (g GraphSONv2Parser) parseTimestamp(in []byte) (time.Time, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if vt != graphson.Timestamp && vt != graphson.Date {
+		return time.Time{}, graphson.ParsingError{Message: "provided input not a g:Timestamp g:Date type", Operation: "parseTimestamp", Field: "@type"}
+	}
+
+	value, err := jsonparser.GetInt(in, "@value")
+	if err != nil {
+		return time.Time{}, graphson.ParsingError{Message: err.Error(), Operation: "parseTimestamp", Field: "@value"}
+	}
+
+	return time.Unix(value/1000, (value%1000)*int64(time.Millisecond)), nil
+}
+
+func (g GraphSONv2Parser) parseClass(in []byte) (string, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return "", err
+	}
+
+	if vt != graphson.Class {
+		return "", graphson.ParsingError{Message: "provided input not g:Class type", Operation: "parseClass", Field: "@type"}
+	}
+
+	return jsonparser.GetString(in, "@value")
+}
+
+func (g GraphSONv2Parser) parseUUID(in []byte) (string, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return "", err
+	}
+
+	if vt != graphson.UUID {
+		return "", graphson.ParsingError{Message: "provided input not g:UUID type", Operation: "parseUUID", Field: "@type"}
+	}
+
+	return jsonparser.GetString(in, "@value")
+}
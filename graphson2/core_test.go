@@ -0,0 +1,136 @@
+package graphson2
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistered(t *testing.T) {
+	assert.IsType(t, GraphSONv2Parser{}, graphson.NewParser("v2"))
+}
+
+func TestListParse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	list, err := g.parseList([]byte(list20))
+
+	assert.Nil(t, err)
+	assert.Len(t, list, 3)
+
+	assert.Equal(t, 1, list[0].Value)
+	assert.Equal(t, "person", list[1].Value)
+	assert.Equal(t, true, list[2].Value)
+}
+
+func TestMapParse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	vp, err := g.Parse([]byte(map20))
+
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Map, vp.Type)
+
+	assert.Equal(t, "name", vp.AsMap().Entries[0].Key.AsString())
+	assert.Equal(t, "age", vp.AsMap().Entries[1].Key.AsString())
+
+	m := vp.AsFlatMap()
+	assert.Len(t, m, 2)
+
+	name := m["name"].(graphson.ValuePair)
+	assert.Equal(t, graphson.List, name.Type)
+	assert.Equal(t, "marko", name.Value.([]graphson.ValuePair)[0].Value)
+
+	age := m["age"].(graphson.ValuePair)
+	assert.Equal(t, 29, age.Value.([]graphson.ValuePair)[0].Value)
+}
+
+func TestPlainParse(t *testing.T) {
+	g := GraphSONv2Parser{}
+
+	vp, err := g.Parse([]byte(`"marko"`))
+	assert.Nil(t, err)
+	assert.Equal(t, "marko", vp.AsString())
+
+	vp, err = g.Parse([]byte(`false`))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Boolean, vp.Type)
+	assert.Equal(t, false, vp.Value)
+
+	vp, err = g.Parse([]byte(`42`))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), vp.AsInt64())
+}
+
+func TestClassParse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	out, err := g.parseClass([]byte(class20))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "java.io.File", out)
+}
+
+func TestUUIDParse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	out, err := g.parseUUID([]byte(uuid20))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "41d2e28a-20a4-4ab0-b379-d810dede3786", out)
+}
+
+func TestInt32Parse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	out, err := g.parseInt32([]byte(integer20))
+
+	assert.Nil(t, err)
+	assert.Equal(t, reflect.TypeOf(out).Kind(), reflect.Int)
+	assert.Equal(t, 100, out)
+}
+
+func TestInt64Parse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	out, err := g.parseInt64([]byte(long20))
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), out)
+}
+
+func TestFloat32Parse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	out, err := g.parseFloat32([]byte(float20))
+
+	assert.Nil(t, err)
+	assert.Equal(t, reflect.TypeOf(out).Kind(), reflect.Float32)
+}
+
+func TestFloat64Parse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	out, err := g.parseFloat64([]byte(double20))
+
+	assert.Nil(t, err)
+	assert.Equal(t, reflect.TypeOf(out).Kind(), reflect.Float64)
+}
+
+func TestTimestampParse(t *testing.T) {
+	g := GraphSONv2Parser{}
+
+	for _, in := range []string{timestamp20, date20} {
+		out, err := g.parseTimestamp([]byte(in))
+		assert.Nil(t, err)
+
+		year, month, day := out.UTC().Date()
+		assert.Equal(t, 2016, year)
+		assert.Equal(t, time.December, month)
+		assert.Equal(t, 14, day)
+	}
+
+	// dates beyond the nanosecond range of an int64, 2262 onwards, and before the epoch
+	out, err := g.parseTimestamp([]byte(`{"@type":"g:Date","@value":32503680000001}`))
+	assert.Nil(t, err)
+	assert.True(t, time.Date(3000, time.January, 1, 0, 0, 0, int(time.Millisecond), time.UTC).Equal(out))
+
+	out, err = g.parseTimestamp([]byte(`{"@type":"g:Timestamp","@value":-1500}`))
+	assert.Nil(t, err)
+	assert.True(t, time.Unix(0, -1500*int64(time.Millisecond)).Equal(out))
+}
@@ -0,0 +1,31 @@
+package graphson2
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoderStrings(t *testing.T) {
+	d := graphson.NewDecoder(strings.NewReader(`{"requestId":"41d2e28a-20a4-4ab0-b379-d810dede3786",`+
+		`"status":{"message":"","code":200,"attributes":{}},"result":{"data":["marko","say \"hi\"",29],"meta":{}}}`), GraphSONv2Parser{})
+
+	values := []graphson.ValuePair{}
+
+	for {
+		vp, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+
+		assert.Nil(t, err)
+		values = append(values, vp)
+	}
+
+	assert.Len(t, values, 3)
+	assert.Equal(t, "marko", values[0].AsString())
+	assert.Equal(t, `say "hi"`, values[1].AsString())
+}
@@ -0,0 +1,186 @@
+package graphson2
+
+import (
+	"strings"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+const edgeTypeName = "g:Edge"
+const propertyTypeName = "g:Property"
+
+// ParseEdge expects the input to be valid JSON and to be a single Edge record. See either the testing file for sample
+// edge json records or http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_edge_2.
+func (g GraphSONv2Parser) ParseEdge(in []byte) (e graphson.EdgeRecord, err error) {
+	e.Properties = map[string]graphson.Property{}
+
+	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || typeName != edgeTypeName {
+		return e, graphson.ParsingError{Message: err, Operation: "parseEdge", Field: "@type"}
+	}
+
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"@value", "id"},
+		{"@value", "label"},
+		{"@value", "inVLabel"},
+		{"@value", "outVLabel"},
+		{"@value", "inV"},
+		{"@value", "outV"},
+		{"@value", "properties"},
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		currentError := graphson.ParsingError{Operation: "parseEdge", Field: strings.Join(paths[idx], " ")}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		switch idx {
+		case 0: // @value -> id
+			id, err := g.elementID(value, vt)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.ID = id
+
+		case 1: // @value -> label
+			label, err := jsonparser.ParseString(value)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.Label = label
+
+		case 2: // @value -> inVLabel
+			label, err := jsonparser.ParseString(value)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.InVLabel = label
+
+		case 3: // @value -> outVLabel
+			label, err := jsonparser.ParseString(value)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.OutVLabel = label
+
+		case 4: // @value -> inV
+			v, err := g.elementID(value, vt)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.InV = v
+
+		case 5: // @value -> outV
+			v, err := g.elementID(value, vt)
+			if err != nil {
+				currentError.Message = err.Error()
+				break
+			}
+
+			e.OutV = v
+
+		case 6: // @value -> properties
+			err = jsonparser.ObjectEach(value, func(key []byte, prop []byte, dataType jsonparser.ValueType, offset int) error {
+				propertyName, err := jsonparser.ParseString(key)
+				if err != nil {
+					return err
+				}
+
+				parsedProperty, err := g.ParseProperty(prop)
+				if err != nil {
+					return err
+				}
+
+				e.Properties[propertyName] = parsedProperty
+
+				return nil
+			})
+
+			if err != nil {
+				currentError.Message = err.Error()
+			}
+		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+
+	}, paths...)
+
+	return e, parsingErrors.Combine()
+}
+
+// ParseProperty expects the input to be valid JSON and to be a single Property record. See either the testing file for sample
+// property json records or http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_property_2.
+func (g GraphSONv2Parser) ParseProperty(in []byte) (property graphson.Property, err error) {
+	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || typeName != propertyTypeName {
+		return property, graphson.ParsingError{Message: err, Operation: "parseProperty", Field: "@type"}
+	}
+
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"@value", "key"},
+		{"@value", "value"},
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		currentError := graphson.ParsingError{Operation: "parseProperty", Field: strings.Join(paths[idx], " ")}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		switch idx {
+		case 0: // @value -> key
+			key, e := jsonparser.ParseString(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			property.Key = key
+
+		case 1: // @value -> value
+			if vt == jsonparser.String {
+				value = quote(value)
+			}
+
+			val, e := g.Parse(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			property.Value = val
+		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+
+	}, paths...)
+
+	return property, parsingErrors.Combine()
+}
@@ -0,0 +1,37 @@
+package graphson2
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEdge(t *testing.T) {
+	g := GraphSONv2Parser{}
+	edge, err := g.ParseEdge([]byte(edge20))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 13}, edge.ID)
+	assert.Equal(t, "develops", edge.Label)
+
+	assert.Equal(t, "person", edge.OutVLabel)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 1}, edge.OutV)
+
+	assert.Equal(t, "software", edge.InVLabel)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 10}, edge.InV)
+
+	assert.Equal(t, 2009, edge.Properties["since"].Value.Value)
+}
+
+func TestParseProperty(t *testing.T) {
+	g := GraphSONv2Parser{}
+	property, err := g.ParseProperty([]byte(property20))
+	assert.Nil(t, err)
+	assert.Equal(t, 2009, property.Value.Value)
+	assert.Equal(t, "since", property.Key)
+
+	vp, err := g.Parse([]byte(property20))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.EdgeProperty, vp.Type)
+	assert.Equal(t, "since", vp.AsProperty().Key)
+}
@@ -0,0 +1,95 @@
+package graphson2
+
+import (
+	"math"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+type GraphSONv2Parser struct{}
+
+// elementID parses an element's ID, keeping the type it was written with so that it can be sent back in a binding as it is
+func (g GraphSONv2Parser) elementID(value []byte, vt jsonparser.ValueType) (graphson.ValuePair, error) {
+	// jsonparser strips the quotes from string IDs, put them back so Parse sees valid JSON
+	if vt == jsonparser.String {
+		value = quote(value)
+	}
+
+	return g.Parse(value)
+}
+
+// getValueType examines a GraphSON 2 value and returns its type. Unlike GraphSON 3, lists and maps are plain JSON arrays
+// and objects, so anything without an @type is identified by its JSON shape.
+func getValueType(in []byte) (graphson.ValueType, error) {
+	value, dt, _, err := jsonparser.Get(in)
+	if err != nil {
+		return graphson.Unknown, err
+	}
+
+	switch dt {
+	case jsonparser.String:
+		return graphson.String, nil
+	case jsonparser.Boolean:
+		return graphson.Boolean, nil
+	case jsonparser.Array:
+		return graphson.List, nil
+	case jsonparser.Object:
+		typeName, err := jsonparser.GetString(value, "@type")
+		if err != nil {
+			return graphson.Map, nil
+		}
+
+		return valueTypeFromString(typeName), nil
+	case jsonparser.Number:
+		n, err := jsonparser.ParseFloat(value)
+		if err != nil {
+			return graphson.Unknown, err
+		}
+
+		// untyped numbers are rare in GraphSON 2 but not forbidden, integral values are taken as Int64 and the rest as Double
+		if math.Trunc(n) == n {
+			return graphson.Int64, nil
+		}
+
+		return graphson.Double, nil
+	}
+
+	return graphson.Unknown, nil
+}
+
+func valueTypeFromString(raw string) graphson.ValueType {
+	switch raw {
+	case "g:Class":
+		return graphson.Class
+	case "g:Date":
+		return graphson.Date
+	case "g:Double":
+		return graphson.Double
+	case "g:Float":
+		return graphson.Float
+	case "g:Int32":
+		return graphson.Int32
+	case "g:Int64":
+		return graphson.Int64
+	case "g:Timestamp":
+		return graphson.Timestamp
+	case "g:UUID":
+		return graphson.UUID
+	case vertexTypeName:
+		return graphson.Vertex
+	case vertexPropertyTypeName:
+		return graphson.VertexProperty
+	case edgeTypeName:
+		return graphson.Edge
+	case propertyTypeName:
+		return graphson.EdgeProperty
+	default:
+		return graphson.Unknown
+	}
+}
+
+func init() {
+	graphson.RegisterParser("v2", GraphSONv2Parser{})
+}
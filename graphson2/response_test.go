@@ -0,0 +1,60 @@
+package graphson2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+const response20 = `{
+  "requestId" : "41d2e28a-20a4-4ab0-b379-d810dede3786",
+  "status" : {
+    "message" : "",
+    "code" : 200,
+    "attributes" : { }
+  },
+  "result" : {
+    "data" : [ {
+      "@type" : "g:Int32",
+      "@value" : 1
+    }, "marko" ],
+    "meta" : { }
+  }
+}`
+
+const unauthorizedResponse20 = `{
+  "requestId" : "41d2e28a-20a4-4ab0-b379-d810dede3786",
+  "status" : {
+    "message" : "Username and/or password are incorrect",
+    "code" : 401,
+    "attributes" : { }
+  },
+  "result" : {
+    "data" : null,
+    "meta" : { }
+  }
+}`
+
+func TestParseResponse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	response, err := g.ParseResponse([]byte(response20))
+	assert.Nil(t, err)
+
+	assert.Equal(t, "41d2e28a-20a4-4ab0-b379-d810dede3786", response.RequestID)
+	assert.Equal(t, graphson.StatusSuccess, response.Status.Code)
+	assert.Equal(t, graphson.Map, response.Meta.Type)
+
+	data := response.Data.Value.([]graphson.ValuePair)
+	assert.Len(t, data, 2)
+	assert.Equal(t, 1, data[0].AsInt32())
+	assert.Equal(t, "marko", data[1].AsString())
+}
+
+func TestParseResponseError(t *testing.T) {
+	g := GraphSONv2Parser{}
+	_, err := g.ParseResponse([]byte(unauthorizedResponse20))
+
+	assert.True(t, errors.Is(err, graphson.ErrUnauthorized))
+}
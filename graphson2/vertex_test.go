@@ -0,0 +1,39 @@
+package graphson2
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVertex(t *testing.T) {
+	g := GraphSONv2Parser{}
+	vertex, err := g.ParseVertex([]byte(vertex20))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 1}, vertex.ID)
+	assert.Equal(t, "person", vertex.Label)
+
+	assert.Len(t, vertex.Properties["name"], 1)
+	assert.Len(t, vertex.Properties["location"], 2)
+	assert.Equal(t, "marko", vertex.Properties["name"][0].Value.AsString())
+	assert.Equal(t, "san diego", vertex.Properties["location"][0].Value.AsString())
+	assert.Equal(t, 1997, vertex.Properties["location"][0].Properties["startTime"].Value)
+}
+
+func TestParseVertexProperty(t *testing.T) {
+	g := GraphSONv2Parser{}
+	property, err := g.ParseVertexProperty([]byte(vertexProperty20))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int64, Value: int64(0)}, property.ID)
+	assert.Equal(t, "marko", property.Value.AsString())
+	assert.Equal(t, "name", property.Label)
+}
+
+func TestParseVertexThroughParse(t *testing.T) {
+	g := GraphSONv2Parser{}
+	vp, err := g.Parse([]byte(vertex20))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Vertex, vp.Type)
+	assert.Equal(t, "person", vp.AsVertex().Label)
+}
@@ -1,6 +1,8 @@
 package graphson3
 
 import (
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/buger/jsonparser"
@@ -14,6 +16,10 @@ func (g GraphSONv3Parser) Parse(in []byte) (graphson.ValuePair, error) {
 		return graphson.ValuePair{}, err
 	}
 
+	if typeName == graphson.Unknown {
+		return g.parseCustom(in)
+	}
+
 	var out interface{}
 
 	switch typeName {
@@ -25,23 +31,47 @@ func (g GraphSONv3Parser) Parse(in []byte) (graphson.ValuePair, error) {
 		out, err = g.ParseEdge(in)
 	case graphson.EdgeProperty:
 		out, err = g.ParseProperty(in)
+	case graphson.Path:
+		out, err = g.parsePath(in)
+	case graphson.Tree:
+		out, err = g.parseTree(in)
+	case graphson.Traverser:
+		out, err = g.parseTraverser(in)
+	case graphson.TraversalMetrics:
+		out, err = g.parseTraversalMetrics(in)
+	case graphson.Metrics:
+		out, err = g.parseMetrics(in)
+	case graphson.TraversalExplanation:
+		out, err = g.parseTraversalExplanation(in)
+	case graphson.T, graphson.Direction, graphson.Cardinality, graphson.Order, graphson.Pop,
+		graphson.Scope, graphson.Column, graphson.Barrier, graphson.Operator, graphson.Pick:
+		out, err = g.parseToken(in, typeName)
+	case graphson.BigDecimal, graphson.BigInteger, graphson.Byte, graphson.ByteBuffer, graphson.Char, graphson.Duration,
+		graphson.Instant, graphson.LocalDate, graphson.LocalDateTime, graphson.LocalTime, graphson.MonthDay,
+		graphson.OffsetDateTime, graphson.OffsetTime, graphson.Period, graphson.Short, graphson.Year, graphson.YearMonth,
+		graphson.ZonedDateTime, graphson.ZoneOffset:
+		out, err = g.parseExtended(in, typeName)
 	case graphson.Set:
 		out, err = g.parseSet(in)
 	case graphson.List:
 		out, err = g.parseSet(in)
+	case graphson.Map:
+		out, err = g.parseMap(in)
+	case graphson.BulkSet:
+		out, err = g.parseBulkSet(in)
 	case graphson.Class:
 		out, err = g.parseClass(in)
 	case graphson.String:
-		out, err = string(in), nil
+		out, err = parseString(in)
 	case graphson.Boolean:
 		out, err = string(in) == "true" || string(in) == "1", nil
 	case graphson.Int32:
 		out, err = g.parseInt32(in)
 	case graphson.Int64:
 		out, err = g.parseInt64(in)
-	case graphson.Float:
-		out, err = g.parseFloat64(in)
 	case graphson.Double:
+		out, err = g.parseFloat64(in)
+	case graphson.Float:
 		out, err = g.parseFloat32(in)
 	case graphson.UUID:
 		out, err = g.parseUUID(in)
@@ -89,32 +119,52 @@ func (g GraphSONv3Parser) parseSet(in []byte) ([]graphson.ValuePair, error) {
 			return
 		}
 
+		if g.UnwrapTraversers && vp.Type == graphson.Traverser {
+			traverser := vp.AsTraverser()
+
+			// a bulk is a count the server never meant to be expanded, keep to the limit BulkSetRecord.Expand has
+			if traverser.Bulk < 0 || traverser.Bulk > graphson.MaxExpandedBulk-int64(len(out)) {
+				currentError.Message = fmt.Sprintf("traverser bulk %d must be between 0 and graphson.MaxExpandedBulk", traverser.Bulk)
+				parsingErrors = append(parsingErrors, currentError)
+				return
+			}
+
+			for i := int64(0); i < traverser.Bulk; i++ {
+				out = append(out, traverser.Value)
+			}
+
+			return
+		}
+
 		out = append(out, vp)
 	})
 
 	return out, parsingErrors.Combine()
 }
 
-func (g GraphSONv3Parser) parseFlatMap(in []byte) ([]graphson.ValuePair, error) {
-	ordered := []graphson.ValuePair{}
+// parseBulkSet handles g:BulkSet, whose array alternates between a value and its g:Int64 bulk
+func (g GraphSONv3Parser) parseBulkSet(in []byte) (graphson.BulkSetRecord, error) {
+	out := graphson.BulkSetRecord{Entries: []graphson.BulkEntry{}}
 
 	vt, err := getValueType(in)
 	if err != nil {
-		return nil, err
+		return out, err
 	}
 
-	if vt != graphson.Map {
-		return nil, graphson.ParsingError{Message: "provided input not a g:Map type", Operation: "parseMap", Field: "@type"}
+	if vt != graphson.BulkSet {
+		return out, graphson.ParsingError{Message: "provided input not a g:BulkSet type", Operation: "parseBulkSet", Field: "@type"}
 	}
 
 	value, dt, _, err := jsonparser.Get(in, "@value")
 	if dt != jsonparser.Array {
-		return nil, graphson.ParsingError{Message: "provided input not a valid g:Map type, bad array", Operation: "parseMap", Field: "@type"}
+		return out, graphson.ParsingError{Message: "provided input not a valid g:BulkSet type, bad array", Operation: "parseBulkSet", Field: "@value"}
 	}
 
 	parsingErrors := graphson.ParsingErrors{}
+	index := 0
 	_, err = jsonparser.ArrayEach(value, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
-		currentError := graphson.ParsingError{Operation: "parseMap", Field: "@value"}
+		currentError := graphson.ParsingError{Operation: "parseBulkSet", Field: "@value"}
+		defer func() { index++ }()
 
 		if err != nil {
 			currentError.Message = err.Error()
@@ -129,12 +179,133 @@ func (g GraphSONv3Parser) parseFlatMap(in []byte) ([]graphson.ValuePair, error)
 			return
 		}
 
-		ordered = append(ordered, vp)
+		if index%2 == 0 {
+			out.Entries = append(out.Entries, graphson.BulkEntry{Value: vp})
+			return
+		}
+
+		if vp.Type != graphson.Int64 {
+			currentError.Message = "g:BulkSet bulk must be a g:Int64"
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		if vp.AsInt64() < 0 {
+			currentError.Message = "g:BulkSet bulk must not be negative"
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		if len(out.Entries) > 0 {
+			out.Entries[len(out.Entries)-1].Bulk = vp.AsInt64()
+		}
+	})
+
+	if index%2 != 0 {
+		parsingErrors = append(parsingErrors, graphson.ParsingError{Message: "g:BulkSet value missing its bulk", Operation: "parseBulkSet", Field: "@value"})
+	}
+
+	return out, parsingErrors.Combine()
+}
+
+// parseTraverser handles g:Traverser, a value and the g:Int64 bulk of traversers it represents
+func (g GraphSONv3Parser) parseTraverser(in []byte) (traverser graphson.TraverserRecord, err error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return traverser, err
+	}
+
+	if vt != graphson.Traverser {
+		return traverser, graphson.ParsingError{Message: "provided input not a g:Traverser type", Operation: "parseTraverser", Field: "@type"}
+	}
+
+	bulk, err := jsonparser.GetInt(in, "@value", "bulk", "@value")
+	if err != nil {
+		return traverser, graphson.ParsingError{Message: err.Error(), Operation: "parseTraverser", Field: "@value bulk"}
+	}
+
+	value, _, _, err := jsonparser.Get(in, "@value", "value")
+	if err != nil {
+		return traverser, graphson.ParsingError{Message: err.Error(), Operation: "parseTraverser", Field: "@value value"}
+	}
+
+	traverser.Bulk = bulk
+	traverser.Value, err = g.Parse(value)
+
+	return traverser, err
+}
+
+// parseMap handles g:Map, whose array alternates between keys and values. Keys may be of any type and entry order is
+// preserved.
+func (g GraphSONv3Parser) parseMap(in []byte) (graphson.MapRecord, error) {
+	out := graphson.MapRecord{Entries: []graphson.MapEntry{}}
+
+	err := g.eachMapEntry(in, func(key graphson.ValuePair, value []byte) error {
+		vp, err := g.Parse(value)
+		if err != nil {
+			return err
+		}
+
+		out.Entries = append(out.Entries, graphson.MapEntry{Key: key, Value: vp})
+
+		return nil
+	})
+
+	return out, err
+}
+
+// eachMapEntry walks the raw entries of a g:Map, parsing each key but leaving the value for fn to interpret
+func (g GraphSONv3Parser) eachMapEntry(in []byte, fn func(key graphson.ValuePair, value []byte) error) error {
+	if vt, err := getValueType(in); err != nil || vt != graphson.Map {
+		return graphson.ParsingError{Message: "provided input not a g:Map type", Operation: "parseMap", Field: "@type"}
+	}
+
+	value, dt, _, err := jsonparser.Get(in, "@value")
+	if err != nil || dt != jsonparser.Array {
+		return graphson.ParsingError{Message: "provided input not a valid g:Map type, bad array", Operation: "parseMap", Field: "@value"}
+	}
+
+	var key *graphson.ValuePair
+	var entryErr error
+
+	_, err = jsonparser.ArrayEach(value, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		if entryErr != nil {
+			return
+		}
+
+		if err != nil {
+			entryErr = err
+			return
+		}
+
+		if key == nil {
+			k, err := g.Parse(value)
+			if err != nil {
+				entryErr = err
+				return
+			}
+
+			if g.Neptune {
+				k = neptuneKey(k)
+			}
 
+			key = &k
+			return
+		}
+
+		entryErr = fn(*key, value)
+		key = nil
 	})
 
-	// because g:Map relies so heavily on element order it is suggested that results are always ignored if any error is present
-	return ordered, nil
+	if err != nil {
+		return err
+	}
+
+	if entryErr == nil && key != nil {
+		entryErr = graphson.ParsingError{Message: "g:Map key missing its value", Operation: "parseMap", Field: "@value"}
+	}
+
+	return entryErr
 }
 
 func (g GraphSONv3Parser) parseInt32(in []byte) (int, error) {
@@ -172,11 +343,11 @@ func (g GraphSONv3Parser) parseFloat32(in []byte) (float32, error) {
 		return 0, err
 	}
 
-	if vt != graphson.Double {
-		return 0, graphson.ParsingError{Message: "provided input not a g:Float32 type", Operation: "parseFloat32", Field: "@type"}
+	if vt != graphson.Float {
+		return 0, graphson.ParsingError{Message: "provided input not a g:Float type", Operation: "parseFloat32", Field: "@type"}
 	}
 
-	value, err := jsonparser.GetFloat(in, "@value")
+	value, err := getFloat(in)
 
 	return float32(value), err
 }
@@ -187,11 +358,33 @@ func (g GraphSONv3Parser) parseFloat64(in []byte) (float64, error) {
 		return 0, err
 	}
 
-	if vt != graphson.Float {
-		return 0, graphson.ParsingError{Message: "provided input not a g:Float64 type", Operation: "parseFloat64", Field: "@type"}
+	if vt != graphson.Double {
+		return 0, graphson.ParsingError{Message: "provided input not a g:Double type", Operation: "parseFloat64", Field: "@type"}
 	}
 
-	return jsonparser.GetFloat(in, "@value")
+	return getFloat(in)
+}
+
+// getFloat reads the @value of a g:Double or g:Float, including the NaN, Infinity and -Infinity JSON can only hold as
+// strings.
+func getFloat(in []byte) (float64, error) {
+	value, dataType, _, err := jsonparser.Get(in, "@value")
+	if err != nil {
+		return 0, err
+	}
+
+	if dataType == jsonparser.String {
+		switch string(value) {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+	}
+
+	return jsonparser.ParseFloat(value)
 }
 
 func (g GraphSONv3Parser) parseTimestamp(in []byte) (time.Time, error) {
@@ -209,7 +402,63 @@ func (g GraphSONv3Parser) parseTimestamp(in []byte) (time.Time, error) {
 		return time.Time{}, graphson.ParsingError{Message: err.Error(), Operation: "parseTimestamp", Field: "@value"}
 	}
 
-	return time.Unix(value/10000, 0), nil
+	if g.Neptune && vt == graphson.Date {
+		return neptuneDate(value), nil
+	}
+
+	// GraphSON dates and timestamps are milliseconds since the unix epoch
+	return time.Unix(value/1000, (value%1000)*int64(time.Millisecond)), nil
+}
+
+// parseToken handles the Gremlin enums, returning the token as the string type matching its enum so that tokens from
+// different enums never compare equal
+func (g GraphSONv3Parser) parseToken(in []byte, expected graphson.ValueType) (interface{}, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if vt != expected {
+		return nil, graphson.ParsingError{Message: "provided input not the expected enum type", Operation: "parseToken", Field: "@type"}
+	}
+
+	name, err := jsonparser.GetString(in, "@value")
+	if err != nil {
+		return nil, graphson.ParsingError{Message: err.Error(), Operation: "parseToken", Field: "@value"}
+	}
+
+	switch vt {
+	case graphson.T:
+		return graphson.TToken(name), nil
+	case graphson.Direction:
+		return graphson.DirectionToken(name), nil
+	case graphson.Cardinality:
+		return graphson.CardinalityToken(name), nil
+	case graphson.Order:
+		return graphson.OrderToken(name), nil
+	case graphson.Pop:
+		return graphson.PopToken(name), nil
+	case graphson.Scope:
+		return graphson.ScopeToken(name), nil
+	case graphson.Column:
+		return graphson.ColumnToken(name), nil
+	case graphson.Barrier:
+		return graphson.BarrierToken(name), nil
+	case graphson.Operator:
+		return graphson.OperatorToken(name), nil
+	default:
+		return graphson.PickToken(name), nil
+	}
+}
+
+// parseString unescapes a plain JSON string. Strings nested in a document arrive without their quotes, as jsonparser
+// yields them, while a string parsed on its own may still be quoted.
+func parseString(in []byte) (string, error) {
+	if len(in) > 1 && in[0] == '"' && in[len(in)-1] == '"' {
+		in = in[1 : len(in)-1]
+	}
+
+	return jsonparser.ParseString(in)
 }
 
 func (g GraphSONv3Parser) parseClass(in []byte) (string, error) {
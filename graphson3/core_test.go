@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dnoberon/graphson"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,12 +21,131 @@ func TestSetParse(t *testing.T) {
 	assert.Equal(t, true, set[2].Value)
 }
 
+func TestBulkSetParse(t *testing.T) {
+	g := GraphSONv3Parser{}
+	vp, err := g.Parse([]byte(bulkSet30))
+
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.BulkSet, vp.Type)
+
+	bulkSet := vp.AsBulkSet()
+	assert.Len(t, bulkSet.Entries, 2)
+	assert.Equal(t, "josh", bulkSet.Entries[1].Value.AsString())
+	assert.Equal(t, int64(2), bulkSet.Entries[1].Bulk)
+	assert.Equal(t, int64(3), bulkSet.Len())
+
+	expanded := vp.AsSet()
+	assert.Len(t, expanded, 3)
+	assert.Equal(t, "marko", expanded[0].AsString())
+	assert.Equal(t, "josh", expanded[1].AsString())
+	assert.Equal(t, "josh", expanded[2].AsString())
+
+	_, err = g.Parse([]byte(`{"@type":"g:BulkSet","@value":["marko"]}`))
+	assert.NotNil(t, err)
+
+	_, err = g.Parse([]byte(`{"@type":"g:BulkSet","@value":["a",{"@type":"g:Int64","@value":-1}]}`))
+	assert.NotNil(t, err)
+}
+
+func TestTraverserParse(t *testing.T) {
+	g := GraphSONv3Parser{}
+	list, err := g.parseSet([]byte(traversers30))
+
+	assert.Nil(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, graphson.Traverser, list[0].Type)
+	assert.Equal(t, int64(2), list[0].AsTraverser().Bulk)
+	assert.Equal(t, "marko", list[0].AsTraverser().Value.AsString())
+	assert.Equal(t, 29, list[1].AsTraverser().Value.AsInt32())
+
+	g.UnwrapTraversers = true
+	list, err = g.parseSet([]byte(traversers30))
+
+	assert.Nil(t, err)
+	assert.Len(t, list, 3)
+	assert.Equal(t, "marko", list[0].AsString())
+	assert.Equal(t, "marko", list[1].AsString())
+	assert.Equal(t, 29, list[2].AsInt32())
+
+	for _, bulk := range []string{"4611686018427387904", "-1"} {
+		_, err = g.parseSet([]byte(`{"@type":"g:List","@value":[{"@type":"g:Traverser","@value":{"bulk":{"@type":"g:Int64","@value":` + bulk + `},"value":"marko"}}]}`))
+		assert.NotNil(t, err, bulk)
+	}
+}
+
 func TestMapParse(t *testing.T) {
 	g := GraphSONv3Parser{}
-	m, err := g.parseFlatMap([]byte(map30))
+	m, err := g.parseMap([]byte(map30))
 
 	assert.Nil(t, err)
-	assert.NotEmpty(t, m)
+	assert.Equal(t, 3, m.Len())
+
+	assert.Equal(t, graphson.Date, m.Entries[0].Key.Type)
+	assert.Equal(t, "red", m.Entries[0].Value.AsString())
+
+	assert.Equal(t, graphson.List, m.Entries[1].Key.Type)
+	assert.Len(t, m.Entries[1].Key.Value, 3)
+	assert.Equal(t, graphson.Date, m.Entries[1].Value.Type)
+
+	value, ok := m.Get("test")
+	assert.True(t, ok)
+	assert.Equal(t, 123, value.AsInt32())
+
+	value, ok = m.Lookup(m.Entries[1].Key)
+	assert.True(t, ok)
+	assert.Equal(t, m.Entries[1].Value, value)
+
+	_, err = g.parseMap([]byte(`{"@type":"g:Map","@value":["test"]}`))
+	assert.NotNil(t, err)
+}
+
+func TestTokenParse(t *testing.T) {
+	g := GraphSONv3Parser{}
+
+	cases := map[string]graphson.ValuePair{
+		`{"@type":"g:T","@value":"label"}`:          {Type: graphson.T, Value: graphson.TLabel},
+		`{"@type":"g:Direction","@value":"OUT"}`:    {Type: graphson.Direction, Value: graphson.DirectionOut},
+		`{"@type":"g:Cardinality","@value":"list"}`: {Type: graphson.Cardinality, Value: graphson.CardinalityList},
+		`{"@type":"g:Order","@value":"desc"}`:       {Type: graphson.Order, Value: graphson.OrderDesc},
+		`{"@type":"g:Pop","@value":"last"}`:         {Type: graphson.Pop, Value: graphson.PopLast},
+		`{"@type":"g:Scope","@value":"local"}`:      {Type: graphson.Scope, Value: graphson.ScopeLocal},
+		`{"@type":"g:Column","@value":"keys"}`:      {Type: graphson.Column, Value: graphson.ColumnKeys},
+		`{"@type":"g:Barrier","@value":"normSack"}`: {Type: graphson.Barrier, Value: graphson.BarrierNormSack},
+		`{"@type":"g:Operator","@value":"sum"}`:     {Type: graphson.Operator, Value: graphson.OperatorSum},
+		`{"@type":"g:Pick","@value":"any"}`:         {Type: graphson.Pick, Value: graphson.PickAny},
+	}
+
+	for in, expected := range cases {
+		vp, err := g.Parse([]byte(in))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, vp)
+	}
+}
+
+func TestTokenMapKeys(t *testing.T) {
+	g := GraphSONv3Parser{}
+	vp, err := g.Parse([]byte(elementMap30))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Map, vp.Type)
+
+	m := vp.AsMap()
+	assert.Equal(t, 5, m.Len())
+	assert.Equal(t, graphson.ValuePair{Type: graphson.T, Value: graphson.TID}, m.Entries[0].Key)
+
+	id, ok := m.Get("id")
+	assert.True(t, ok)
+	assert.Equal(t, 13, id.AsInt32())
+
+	in, ok := m.Lookup(graphson.ValuePair{Type: graphson.Direction, Value: graphson.DirectionIn})
+	assert.True(t, ok)
+
+	label, ok := in.AsMap().Get(string(graphson.TLabel))
+	assert.True(t, ok)
+	assert.Equal(t, "software", label.AsString())
+
+	flat := vp.AsFlatMap()
+	assert.Equal(t, "develops", flat[graphson.TLabel].(graphson.ValuePair).AsString())
+	assert.Equal(t, 2009, flat["since"].(graphson.ValuePair).AsInt32())
 }
 
 func TestClassParse(t *testing.T) {
@@ -62,7 +182,7 @@ func TestInt64Parse(t *testing.T) {
 
 func TestFloat32Parse(t *testing.T) {
 	g := GraphSONv3Parser{}
-	out, err := g.parseFloat32([]byte(double30))
+	out, err := g.parseFloat32([]byte(float30))
 
 	assert.Nil(t, err)
 	assert.Equal(t, reflect.TypeOf(out).Kind(), reflect.Float32)
@@ -70,7 +190,7 @@ func TestFloat32Parse(t *testing.T) {
 
 func TestFloat64Parse(t *testing.T) {
 	g := GraphSONv3Parser{}
-	out, err := g.parseFloat64([]byte(float30))
+	out, err := g.parseFloat64([]byte(double30))
 
 	assert.Nil(t, err)
 	assert.Equal(t, reflect.TypeOf(out).Kind(), reflect.Float64)
@@ -82,17 +202,18 @@ func TestTimestampParse(t *testing.T) {
 
 	assert.Nil(t, err)
 
-	year, month, day := out.Date()
-	assert.Equal(t, 1974, year)
-	assert.Equal(t, time.September, month)
-	assert.Equal(t, 11, day)
+	year, month, day := out.UTC().Date()
+	assert.Equal(t, 2016, year)
+	assert.Equal(t, time.December, month)
+	assert.Equal(t, 14, day)
+	assert.Equal(t, int64(1481750076295), out.UnixNano()/int64(time.Millisecond))
 
 	out, err = g.parseTimestamp([]byte(date30))
 
 	assert.Nil(t, err)
 
-	year, month, day = out.Date()
-	assert.Equal(t, 1974, year)
-	assert.Equal(t, time.September, month)
-	assert.Equal(t, 11, day)
+	year, month, day = out.UTC().Date()
+	assert.Equal(t, 2016, year)
+	assert.Equal(t, time.December, month)
+	assert.Equal(t, 14, day)
 }
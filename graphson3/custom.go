@@ -0,0 +1,57 @@
+package graphson3
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+// parseCustom handles the @type names GraphSON 3 doesn't define using the types registered with graphson.RegisterType.
+// Anything else is returned as Unknown.
+func (g GraphSONv3Parser) parseCustom(in []byte) (graphson.ValuePair, error) {
+	name, err := jsonparser.GetString(in, "@type")
+	if err != nil {
+		return graphson.ValuePair{Type: graphson.Unknown}, nil
+	}
+
+	vt, decode, ok := graphson.LookupType(name)
+	if !ok {
+		return graphson.ValuePair{Type: graphson.Unknown}, nil
+	}
+
+	value, dt, offset, err := jsonparser.Get(in, "@value")
+	if err != nil {
+		return graphson.ValuePair{Type: vt}, graphson.ParsingError{Message: err.Error(), Operation: "parseCustom", Field: "@value"}
+	}
+
+	// jsonparser strips the quotes from strings, hand the decoder the @value exactly as written
+	if dt == jsonparser.String {
+		value = in[offset-len(value)-2 : offset]
+	}
+
+	out, err := decode(g, value)
+
+	return graphson.ValuePair{Type: vt, Value: out}, err
+}
+
+// writeCustom handles the ValueTypes GraphSON 3 doesn't define using the types registered with graphson.RegisterType
+func (s GraphSONv3Serializer) writeCustom(buf *bytes.Buffer, in graphson.ValuePair) error {
+	name, encode, ok := graphson.LookupTypeEncoder(in.Type)
+	if !ok || encode == nil {
+		return graphson.ParsingError{Message: fmt.Sprintf("unable to serialize value type %v", in.Type), Operation: "serialize", Field: "@type"}
+	}
+
+	return writeTyped(buf, name, func() error {
+		out, err := encode(s, in.Value)
+		if err != nil {
+			return err
+		}
+
+		buf.Write(out)
+
+		return nil
+	})
+}
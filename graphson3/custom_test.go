@@ -0,0 +1,90 @@
+package graphson3
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/buger/jsonparser"
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+var testBlob = graphson.RegisterType("dse:Blob", func(p graphson.GraphSONParser, value []byte) (interface{}, error) {
+	var raw string
+	if err := json.Unmarshal(value, &raw); err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(raw)
+}, func(s graphson.GraphSONSerializer, value interface{}) ([]byte, error) {
+	return json.Marshal(base64.StdEncoding.EncodeToString(value.([]byte)))
+})
+
+// testPoint is registered without an encoder, its coordinates are parsed with the parser handed to the decoder
+var testPoint = graphson.RegisterType("dse:Point", func(p graphson.GraphSONParser, value []byte) (interface{}, error) {
+	point := []float64{}
+
+	_, err := jsonparser.ArrayEach(value, func(item []byte, dataType jsonparser.ValueType, offset int, err error) {
+		vp, _ := p.Parse(item)
+		point = append(point, vp.AsFloat64())
+	})
+
+	return point, err
+}, nil)
+
+func TestParseRegisteredType(t *testing.T) {
+	g := GraphSONv3Parser{}
+
+	vp, err := g.Parse([]byte(`{"@type":"dse:Blob","@value":"c29tZSBieXRlcw=="}`))
+	assert.Nil(t, err)
+	assert.Equal(t, testBlob, vp.Type)
+	assert.Equal(t, []byte("some bytes"), vp.Value)
+
+	vp, err = g.Parse([]byte(`{"@type":"dse:Point","@value":[{"@type":"g:Double","@value":1.5},{"@type":"g:Double","@value":2.5}]}`))
+	assert.Nil(t, err)
+	assert.Equal(t, testPoint, vp.Type)
+	assert.Equal(t, []float64{1.5, 2.5}, vp.Value)
+
+	vp, err = g.Parse([]byte(`{"@type":"dse:Polygon","@value":[]}`))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Unknown, vp.Type)
+}
+
+func TestRegisterType(t *testing.T) {
+	assert.NotEqual(t, testBlob, testPoint)
+	assert.True(t, testBlob > graphson.Unknown && testPoint > graphson.Unknown)
+
+	vt, decode, ok := graphson.LookupType("dse:Blob")
+	assert.True(t, ok)
+	assert.Equal(t, testBlob, vt)
+
+	// registering a name again keeps the original ValueType
+	assert.Equal(t, testBlob, graphson.RegisterType("dse:Blob", decode, func(s graphson.GraphSONSerializer, value interface{}) ([]byte, error) {
+		return json.Marshal(base64.StdEncoding.EncodeToString(value.([]byte)))
+	}))
+
+	name, encode, ok := graphson.LookupTypeEncoder(testBlob)
+	assert.True(t, ok)
+	assert.Equal(t, "dse:Blob", name)
+	assert.NotNil(t, encode)
+
+	_, _, ok = graphson.LookupType("dse:Polygon")
+	assert.False(t, ok)
+}
+
+func TestSerializeRegisteredType(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	out, err := s.Serialize(graphson.ValuePair{Type: testBlob, Value: []byte("some bytes")})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"@type":"dse:Blob","@value":"c29tZSBieXRlcw=="}`, string(out))
+
+	reparsed, err := g.Parse(out)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("some bytes"), reparsed.Value)
+
+	_, err = s.Serialize(graphson.ValuePair{Type: testPoint, Value: []float64{1.5, 2.5}})
+	assert.NotNil(t, err, "dse:Point was registered without an encoder")
+}
@@ -0,0 +1,100 @@
+package graphson3
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeAll(t *testing.T, in string) []graphson.ValuePair {
+	d := graphson.NewDecoder(strings.NewReader(in), GraphSONv3Parser{})
+	values := []graphson.ValuePair{}
+
+	for {
+		vp, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+
+		assert.Nil(t, err)
+		values = append(values, vp)
+	}
+
+	// the decoder keeps returning io.EOF once finished
+	_, err := d.Next()
+	assert.Equal(t, io.EOF, err)
+
+	return values
+}
+
+func TestDecoderList(t *testing.T) {
+	values := decodeAll(t, `{"@type":"g:List","@value":[`+vertex30+`,`+edge30+`,"plain","true"]}`)
+	assert.Len(t, values, 4)
+
+	assert.Equal(t, graphson.Vertex, values[0].Type)
+	assert.Equal(t, "person", values[0].AsVertex().Label)
+	assert.Equal(t, graphson.Edge, values[1].Type)
+	assert.Equal(t, "plain", values[2].AsString())
+	assert.Equal(t, graphson.ValuePair{Type: graphson.String, Value: "true"}, values[3], "a string is not mistaken for a boolean")
+
+	values = decodeAll(t, `[{"@type":"g:Int32","@value":1},{"@type":"g:Int32","@value":2}]`)
+	assert.Equal(t, []graphson.ValuePair{{Type: graphson.Int32, Value: 1}, {Type: graphson.Int32, Value: 2}}, values)
+}
+
+func TestDecoderResponse(t *testing.T) {
+	values := decodeAll(t, response30)
+	assert.Equal(t, []graphson.ValuePair{{Type: graphson.Int32, Value: 1}, {Type: graphson.Int64, Value: int64(2)}}, values)
+
+	assert.Empty(t, decodeAll(t, noContentResponse30))
+}
+
+func TestDecoderErrors(t *testing.T) {
+	_, err := graphson.NewDecoder(strings.NewReader(vertex30), GraphSONv3Parser{}).Next()
+	assert.NotNil(t, err, "a vertex is not a list")
+
+	d := graphson.NewDecoder(strings.NewReader(`{"@type":"g:List","@value":[{"@type":"g:Int32","@value":1},`), GraphSONv3Parser{})
+	_, err = d.Next()
+	assert.Nil(t, err)
+	_, err = d.Next()
+	assert.NotNil(t, err)
+	assert.NotEqual(t, io.EOF, err)
+
+	// the type of a value can only be checked when it's written first
+	_, err = graphson.NewDecoder(strings.NewReader(`{"@value":["a",1,"b",2],"@type":"g:Map"}`), GraphSONv3Parser{}).Next()
+	assert.NotNil(t, err, "a map is not a list")
+
+	_, err = graphson.NewDecoder(strings.NewReader(`{"@value":["a","b"],"@type":"g:List"}`), GraphSONv3Parser{}).Next()
+	assert.NotNil(t, err)
+}
+
+func TestDecoderErrorResponse(t *testing.T) {
+	d := graphson.NewDecoder(strings.NewReader(errorResponse30), GraphSONv3Parser{})
+	_, err := d.Next()
+	assert.True(t, errors.Is(err, graphson.ErrScriptEvaluationError))
+
+	_, err = d.Next()
+	assert.Equal(t, io.EOF, err)
+
+	// a status written after the result is checked once the list has been read
+	d = graphson.NewDecoder(strings.NewReader(`{"result":{"data":{"@type":"g:List","@value":[{"@type":"g:Int32","@value":1}]},"meta":{}},`+
+		`"status":{"code":500,"message":"boom","attributes":{}},"requestId":"41d2e28a-20a4-4ab0-b379-d810dede3786"}`), GraphSONv3Parser{})
+
+	vp, err := d.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, vp.AsInt32())
+
+	_, err = d.Next()
+	assert.True(t, errors.Is(err, graphson.ErrServerError))
+
+	d = graphson.NewDecoder(strings.NewReader(`{"result":{"data":null,"meta":{}},"status":{"code":597,"message":"boom"}}`), GraphSONv3Parser{})
+	_, err = d.Next()
+	assert.True(t, errors.Is(err, graphson.ErrScriptEvaluationError))
+
+	d = graphson.NewDecoder(strings.NewReader(`{"result":{"data":null,"meta":{}},"status":{"code":204,"message":""}}`), GraphSONv3Parser{})
+	_, err = d.Next()
+	assert.Equal(t, io.EOF, err)
+}
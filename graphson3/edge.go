@@ -22,12 +22,12 @@ func (g GraphSONv3Parser) ParseEdge(in []byte) (e graphson.EdgeRecord, err error
 
 	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
 	var paths = [][]string{
-		{"@value", "id", "@value"},
+		{"@value", "id"},
 		{"@value", "label"},
 		{"@value", "inVLabel"},
 		{"@value", "outVLabel"},
-		{"@value", "inV", "@value"},
-		{"@value", "outV", "@value"},
+		{"@value", "inV"},
+		{"@value", "outV"},
 		{"@value", "properties"},
 	}
 
@@ -43,8 +43,8 @@ func (g GraphSONv3Parser) ParseEdge(in []byte) (e graphson.EdgeRecord, err error
 		}
 
 		switch idx {
-		case 0: // @value -> id -> @value
-			id, err := parsedToType(value, vt)
+		case 0: // @value -> id
+			id, err := g.elementID(value, vt)
 			if err != nil {
 				currentError.Message = err.Error()
 				break
@@ -79,8 +79,8 @@ func (g GraphSONv3Parser) ParseEdge(in []byte) (e graphson.EdgeRecord, err error
 
 			e.OutVLabel = label
 
-		case 4: // @value -> inV -> @value
-			v, err := parsedToType(value, vt)
+		case 4: // @value -> inV
+			v, err := g.elementID(value, vt)
 			if err != nil {
 				currentError.Message = err.Error()
 				break
@@ -88,8 +88,8 @@ func (g GraphSONv3Parser) ParseEdge(in []byte) (e graphson.EdgeRecord, err error
 
 			e.InV = v
 
-		case 5: // @value -> outV -> @value
-			v, err := parsedToType(value, vt)
+		case 5: // @value -> outV
+			v, err := g.elementID(value, vt)
 			if err != nil {
 				currentError.Message = err.Error()
 				break
@@ -122,9 +122,14 @@ func (g GraphSONv3Parser) ParseEdge(in []byte) (e graphson.EdgeRecord, err error
 			}
 
 		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+
 	}, paths...)
 
-	return e, nil
+	return e, parsingErrors.Combine()
 }
 
 // ParseProperty expects the input to be valid JSON and to be a single Property record. See either the testing file for sample
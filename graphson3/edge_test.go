@@ -3,6 +3,7 @@ package graphson3
 import (
 	"testing"
 
+	"github.com/dnoberon/graphson"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,18 +11,30 @@ func TestParseEdge(t *testing.T) {
 	g := GraphSONv3Parser{}
 	edge, err := g.ParseEdge([]byte(edge30))
 	assert.Nil(t, err)
-	assert.Equal(t, int64(13), edge.ID)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 13}, edge.ID)
 	assert.Equal(t, "develops", edge.Label)
 
 	assert.Equal(t, "person", edge.OutVLabel)
-	assert.Equal(t, int64(1), edge.OutV)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 1}, edge.OutV)
 
 	assert.Equal(t, "software", edge.InVLabel)
-	assert.Equal(t, int64(10), edge.InV)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 10}, edge.InV)
 
 	assert.Equal(t, 2009, edge.Properties["since"].Value.Value)
 }
 
+func TestParseMalformedEdge(t *testing.T) {
+	g := GraphSONv3Parser{}
+
+	for _, in := range []string{
+		`{"@type":"g:Edge","@value":{"id":{"@type":"g:Int32","@value":13},"label":"develops","inV":[1],"outV":{"@type":"g:Int32","@value":1}}}`,
+		`{"@type":"g:Edge","@value":{"id":{"@type":"g:Int32","@value":13},"label":"develops","properties":{"since":{"@type":"g:Int32","@value":2009}}}}`,
+	} {
+		_, err := g.ParseEdge([]byte(in))
+		assert.NotNil(t, err, in)
+	}
+}
+
 func TestParseProperty(t *testing.T) {
 	g := GraphSONv3Parser{}
 	property, err := g.ParseProperty([]byte(property30))
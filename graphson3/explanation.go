@@ -0,0 +1,176 @@
+package graphson3
+
+import (
+	"bytes"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+const traversalExplanationTypeName = "g:TraversalExplanation"
+
+// parseTraversalExplanation expects the input to be valid JSON and to be a single TraversalExplanation record, the
+// result of an explain() step. See http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_traversalexplanation_3. Gremlin
+// Server writes the sections as a g:Map, though the plain JSON object of earlier versions is also accepted.
+func (g GraphSONv3Parser) parseTraversalExplanation(in []byte) (explanation graphson.TraversalExplanationRecord, err error) {
+	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || typeName != traversalExplanationTypeName {
+		return explanation, graphson.ParsingError{Message: "provided input not a g:TraversalExplanation type", Operation: "parseTraversalExplanation", Field: "@type"}
+	}
+
+	value, _, _, err := jsonparser.Get(in, "@value")
+	if err != nil {
+		return explanation, graphson.ParsingError{Message: err.Error(), Operation: "parseTraversalExplanation", Field: "@value"}
+	}
+
+	err = g.eachEntry(value, func(key string, value []byte) error {
+		switch key {
+		case "original":
+			explanation.Original, err = g.parseStringList(value)
+			return err
+
+		case "final":
+			explanation.Final, err = g.parseStringList(value)
+			return err
+
+		case "intermediate":
+			return g.eachElement(value, func(value []byte) error {
+				application := graphson.StrategyApplicationRecord{}
+
+				err := g.eachEntry(value, func(key string, value []byte) error {
+					var err error
+
+					switch key {
+					case "strategy":
+						application.Strategy, err = jsonparser.ParseString(value)
+					case "category":
+						application.Category, err = jsonparser.ParseString(value)
+					case "traversal":
+						application.Traversal, err = g.parseStringList(value)
+					}
+
+					return err
+				})
+
+				explanation.Intermediate = append(explanation.Intermediate, application)
+
+				return err
+			})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return explanation, graphson.ParsingError{Message: err.Error(), Operation: "parseTraversalExplanation", Field: "@value"}
+	}
+
+	return explanation, nil
+}
+
+// eachEntry walks either a g:Map with string keys or a plain JSON object, leaving each value for fn to interpret
+func (g GraphSONv3Parser) eachEntry(in []byte, fn func(key string, value []byte) error) error {
+	if vt, err := getValueType(in); err == nil && vt == graphson.Map {
+		return g.eachMapEntry(in, func(key graphson.ValuePair, value []byte) error {
+			return fn(key.AsString(), value)
+		})
+	}
+
+	return jsonparser.ObjectEach(in, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
+		return fn(string(key), value)
+	})
+}
+
+// eachElement walks either a g:List or a plain JSON array, leaving each element for fn to interpret
+func (g GraphSONv3Parser) eachElement(in []byte, fn func(value []byte) error) error {
+	if vt, err := getValueType(in); err == nil && vt == graphson.List {
+		value, _, _, err := jsonparser.Get(in, "@value")
+		if err != nil {
+			return err
+		}
+
+		in = value
+	}
+
+	var elementErr error
+
+	_, err := jsonparser.ArrayEach(in, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		if elementErr == nil {
+			elementErr = fn(value)
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return elementErr
+}
+
+func (g GraphSONv3Parser) parseStringList(in []byte) ([]string, error) {
+	out := []string{}
+
+	err := g.eachElement(in, func(value []byte) error {
+		step, err := jsonparser.ParseString(value)
+		out = append(out, step)
+
+		return err
+	})
+
+	return out, err
+}
+
+func (s GraphSONv3Serializer) writeTraversalExplanation(buf *bytes.Buffer, in graphson.TraversalExplanationRecord) error {
+	return writeTyped(buf, traversalExplanationTypeName, func() error {
+		return writeTyped(buf, "g:Map", func() error {
+			return s.writeStringMap(buf, []string{"original", "intermediate", "final"}, func(key string) error {
+				switch key {
+				case "original":
+					return s.writeStringList(buf, in.Original)
+				case "final":
+					return s.writeStringList(buf, in.Final)
+				}
+
+				return writeTyped(buf, "g:List", func() error {
+					buf.WriteByte('[')
+
+					for i, application := range in.Intermediate {
+						if i > 0 {
+							buf.WriteByte(',')
+						}
+
+						err := writeTyped(buf, "g:Map", func() error {
+							return s.writeStringMap(buf, []string{"strategy", "category", "traversal"}, func(key string) error {
+								switch key {
+								case "strategy":
+									return writeString(buf, application.Strategy)
+								case "category":
+									return writeString(buf, application.Category)
+								}
+
+								return s.writeStringList(buf, application.Traversal)
+							})
+						})
+
+						if err != nil {
+							return err
+						}
+					}
+
+					buf.WriteByte(']')
+
+					return nil
+				})
+			})
+		})
+	})
+}
+
+func (s GraphSONv3Serializer) writeStringList(buf *bytes.Buffer, in []string) error {
+	list := make([]graphson.ValuePair, 0, len(in))
+	for _, value := range in {
+		list = append(list, graphson.ValuePair{Type: graphson.String, Value: value})
+	}
+
+	return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.List, Value: list})
+}
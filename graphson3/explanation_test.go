@@ -0,0 +1,53 @@
+package graphson3
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTraversalExplanation(t *testing.T) {
+	g := GraphSONv3Parser{}
+	vp, err := g.Parse([]byte(traversalExplanation30))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.TraversalExplanation, vp.Type)
+
+	explanation := vp.AsTraversalExplanation()
+	assert.Equal(t, []string{"GraphStep(vertex,[])", "VertexStep(OUT,edge)", "EdgeVertexStep(IN)"}, explanation.Original)
+	assert.Equal(t, []string{"TinkerGraphStep(vertex,[])", "VertexStep(OUT,vertex)"}, explanation.Final)
+	assert.Len(t, explanation.Intermediate, 3)
+	assert.Equal(t, "ConnectiveStrategy", explanation.Intermediate[0].Strategy)
+	assert.Equal(t, "DecorationStrategy", explanation.Intermediate[0].Category)
+
+	rewrites := explanation.Rewrites()
+	assert.Len(t, rewrites, 2)
+	assert.Equal(t, "IncidentToAdjacentStrategy", rewrites[0].Strategy)
+	assert.Equal(t, "TinkerGraphStepStrategy", rewrites[1].Strategy)
+}
+
+func TestParseTraversalExplanationObject(t *testing.T) {
+	g := GraphSONv3Parser{}
+	vp, err := g.Parse([]byte(traversalExplanationObject30))
+	assert.Nil(t, err)
+
+	explanation := vp.AsTraversalExplanation()
+	assert.Len(t, explanation.Original, 3)
+	assert.Len(t, explanation.Intermediate, 1)
+	assert.Equal(t, []string{"GraphStep(vertex,[])", "VertexStep(OUT,vertex)"}, explanation.Intermediate[0].Traversal)
+}
+
+func TestSerializeTraversalExplanation(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	original, err := g.Parse([]byte(traversalExplanation30))
+	assert.Nil(t, err)
+
+	out, err := s.Serialize(original)
+	assert.Nil(t, err)
+
+	reparsed, err := g.Parse(out)
+	assert.Nil(t, err)
+	assert.Equal(t, original, reparsed)
+}
@@ -0,0 +1,392 @@
+package graphson3
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+// extendedTypeNames maps the ValueTypes of the GraphSON extended module to their gx: type names
+var extendedTypeNames = map[graphson.ValueType]string{
+	graphson.BigDecimal:     "gx:BigDecimal",
+	graphson.BigInteger:     "gx:BigInteger",
+	graphson.Byte:           "gx:Byte",
+	graphson.ByteBuffer:     "gx:ByteBuffer",
+	graphson.Char:           "gx:Char",
+	graphson.Duration:       "gx:Duration",
+	graphson.Instant:        "gx:Instant",
+	graphson.LocalDate:      "gx:LocalDate",
+	graphson.LocalDateTime:  "gx:LocalDateTime",
+	graphson.LocalTime:      "gx:LocalTime",
+	graphson.MonthDay:       "gx:MonthDay",
+	graphson.OffsetDateTime: "gx:OffsetDateTime",
+	graphson.OffsetTime:     "gx:OffsetTime",
+	graphson.Period:         "gx:Period",
+	graphson.Short:          "gx:Int16",
+	graphson.Year:           "gx:Year",
+	graphson.YearMonth:      "gx:YearMonth",
+	graphson.ZonedDateTime:  "gx:ZonedDateTime",
+	graphson.ZoneOffset:     "gx:ZoneOffset",
+}
+
+var (
+	isoDuration = regexp.MustCompile(`^([-+]?)P(?:([-+]?\d+)D)?(?:T(?:([-+]?\d+)H)?(?:([-+]?\d+)M)?(?:([-+]?\d+)(?:[.,](\d{1,9}))?S)?)?$`)
+	isoPeriod   = regexp.MustCompile(`^([-+]?)P(?:([-+]?\d+)Y)?(?:([-+]?\d+)M)?(?:([-+]?\d+)W)?(?:([-+]?\d+)D)?$`)
+)
+
+// parseExtended handles the gx: types of the GraphSON extended module. Numbers are written as JSON numbers, byte buffers
+// as base64 and everything else as the string Java's toString produces for the type. See
+// http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_extended_2.
+func (g GraphSONv3Parser) parseExtended(in []byte, expected graphson.ValueType) (interface{}, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if vt != expected {
+		return nil, graphson.ParsingError{Message: "provided input not the expected gx: type", Operation: "parseExtended", Field: "@type"}
+	}
+
+	value, dt, _, err := jsonparser.Get(in, "@value")
+	if err != nil {
+		return nil, graphson.ParsingError{Message: err.Error(), Operation: "parseExtended", Field: "@value"}
+	}
+
+	raw := string(value)
+	if dt == jsonparser.String {
+		if raw, err = jsonparser.ParseString(value); err != nil {
+			return nil, graphson.ParsingError{Message: err.Error(), Operation: "parseExtended", Field: "@value"}
+		}
+	}
+
+	out, err := parseExtendedValue(raw, vt)
+	if err != nil {
+		return nil, graphson.ParsingError{Message: fmt.Sprintf("invalid %s %q: %v", extendedTypeNames[vt], raw, err), Operation: "parseExtended", Field: "@value"}
+	}
+
+	return out, nil
+}
+
+func parseExtendedValue(raw string, vt graphson.ValueType) (interface{}, error) {
+	switch vt {
+	case graphson.BigDecimal:
+		// a binary *big.Float can't hold most decimals exactly, 0.1 included, but with four bits a digit and 64 to spare the
+		// shortest decimal rounding to it, which the serializer writes, is the decimal as written less any trailing zeros
+		f, _, err := big.ParseFloat(raw, 10, uint(len(raw))*4+64, big.ToNearestEven)
+		return f, err
+	case graphson.BigInteger:
+		i, ok := new(big.Int).SetString(raw, 10)
+		if !ok {
+			return nil, fmt.Errorf("not an integer")
+		}
+
+		return i, nil
+	case graphson.Byte:
+		i, err := strconv.ParseInt(raw, 10, 8)
+		return int8(i), err
+	case graphson.Short:
+		i, err := strconv.ParseInt(raw, 10, 16)
+		return int16(i), err
+	case graphson.ByteBuffer:
+		return base64.StdEncoding.DecodeString(raw)
+	case graphson.Char:
+		if utf8.RuneCountInString(raw) != 1 {
+			return nil, fmt.Errorf("not a single character")
+		}
+
+		r, _ := utf8.DecodeRuneInString(raw)
+		return r, nil
+	case graphson.Duration:
+		return parseDuration(raw)
+	case graphson.Instant:
+		t, err := time.Parse(time.RFC3339Nano, raw)
+		return t.UTC(), err
+	case graphson.LocalDate:
+		return parseLocalDate(raw)
+	case graphson.LocalDateTime:
+		return parseLocalDateTime(raw)
+	case graphson.LocalTime:
+		return parseLocalTime(raw)
+	case graphson.MonthDay:
+		t, err := time.Parse("--01-02", raw)
+		return graphson.MonthDayRecord{Month: t.Month(), Day: t.Day()}, err
+	case graphson.YearMonth:
+		t, err := time.Parse("2006-01", raw)
+		return graphson.YearMonthRecord{Year: t.Year(), Month: t.Month()}, err
+	case graphson.Year:
+		return strconv.Atoi(raw)
+	case graphson.Period:
+		return parsePeriod(raw)
+	case graphson.ZoneOffset:
+		return parseZoneOffset(raw)
+	case graphson.OffsetTime:
+		return parseOffsetTime(raw)
+	case graphson.OffsetDateTime:
+		return parseOffsetDateTime(raw)
+	case graphson.ZonedDateTime:
+		return parseZonedDateTime(raw)
+	}
+
+	return nil, fmt.Errorf("not a gx: type")
+}
+
+func parseLocalDate(raw string) (graphson.LocalDateRecord, error) {
+	t, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return graphson.LocalDateRecord{}, err
+	}
+
+	return graphson.LocalDateRecord{Year: t.Year(), Month: t.Month(), Day: t.Day()}, nil
+}
+
+// parseLocalTime accepts HH:mm, HH:mm:ss and HH:mm:ss with up to nine fractional digits, the forms Java writes
+func parseLocalTime(raw string) (graphson.LocalTimeRecord, error) {
+	layout := "15:04:05.999999999"
+	if len(raw) == len("15:04") {
+		layout = "15:04"
+	}
+
+	t, err := time.Parse(layout, raw)
+	if err != nil {
+		return graphson.LocalTimeRecord{}, err
+	}
+
+	return graphson.LocalTimeRecord{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}, nil
+}
+
+func parseLocalDateTime(raw string) (dt graphson.LocalDateTimeRecord, err error) {
+	parts := strings.SplitN(raw, "T", 2)
+	if len(parts) != 2 {
+		return dt, fmt.Errorf("missing time")
+	}
+
+	if dt.Date, err = parseLocalDate(parts[0]); err != nil {
+		return dt, err
+	}
+
+	dt.Time, err = parseLocalTime(parts[1])
+
+	return dt, err
+}
+
+func parseZoneOffset(raw string) (graphson.ZoneOffsetRecord, error) {
+	if raw == "Z" {
+		return graphson.ZoneOffsetRecord{}, nil
+	}
+
+	for _, layout := range []string{"-07:00:00", "-07:00", "-07"} {
+		if len(raw) != len(layout) {
+			continue
+		}
+
+		t, err := time.Parse(layout, raw)
+		if err != nil {
+			return graphson.ZoneOffsetRecord{}, err
+		}
+
+		_, offset := t.Zone()
+
+		return graphson.ZoneOffsetRecord{Seconds: offset}, nil
+	}
+
+	return graphson.ZoneOffsetRecord{}, fmt.Errorf("not a zone offset")
+}
+
+func parseOffsetTime(raw string) (ot graphson.OffsetTimeRecord, err error) {
+	split := strings.IndexAny(raw, "Z+-")
+	if split < 0 {
+		return ot, fmt.Errorf("missing offset")
+	}
+
+	if ot.Time, err = parseLocalTime(raw[:split]); err != nil {
+		return ot, err
+	}
+
+	ot.Offset, err = parseZoneOffset(raw[split:])
+
+	return ot, err
+}
+
+func parseOffsetDateTime(raw string) (time.Time, error) {
+	parts := strings.SplitN(raw, "T", 2)
+	if len(parts) != 2 {
+		return time.Time{}, fmt.Errorf("missing time")
+	}
+
+	date, err := parseLocalDate(parts[0])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ot, err := parseOffsetTime(parts[1])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(date.Year, date.Month, date.Day, ot.Time.Hour, ot.Time.Minute, ot.Time.Second, ot.Time.Nanosecond, ot.Offset.Location()), nil
+}
+
+// parseZonedDateTime handles an offset date time optionally followed by a bracketed zone ID. The zone is loaded from the
+// system's time zone database when it's known there, otherwise the time keeps the offset under the zone's name.
+func parseZonedDateTime(raw string) (time.Time, error) {
+	zone := ""
+	if strings.HasSuffix(raw, "]") {
+		open := strings.LastIndex(raw, "[")
+		if open < 0 {
+			return time.Time{}, fmt.Errorf("unterminated zone")
+		}
+
+		raw, zone = raw[:open], raw[open+1:len(raw)-1]
+	}
+
+	t, err := parseOffsetDateTime(raw)
+	if err != nil || zone == "" {
+		return t, err
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		_, offset := t.Zone()
+		loc = time.FixedZone(zone, offset)
+	}
+
+	return t.In(loc), nil
+}
+
+// parseDuration reads the ISO-8601 form written by Java's Duration.toString, e.g. PT8H6M12.345S. Durations longer than
+// time.Duration can hold, roughly 290 years, overflow.
+func parseDuration(raw string) (time.Duration, error) {
+	match := isoDuration.FindStringSubmatch(raw)
+	if match == nil || strings.HasSuffix(raw, "T") || (match[2] == "" && match[3] == "" && match[4] == "" && match[5] == "") {
+		return 0, fmt.Errorf("not an ISO-8601 duration")
+	}
+
+	var out time.Duration
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if match[i+2] == "" {
+			continue
+		}
+
+		n, err := strconv.ParseInt(match[i+2], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		out += time.Duration(n) * unit
+	}
+
+	if fraction := match[6]; fraction != "" {
+		nanos, err := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		// the fraction carries the sign of the seconds, as in PT-0.5S
+		if strings.HasPrefix(match[5], "-") {
+			nanos = -nanos
+		}
+
+		out += time.Duration(nanos)
+	}
+
+	if match[1] == "-" {
+		out = -out
+	}
+
+	return out, nil
+}
+
+// parsePeriod reads the ISO-8601 form written by Java's Period.toString, e.g. P1Y6M15D. Weeks are folded in to days.
+func parsePeriod(raw string) (p graphson.PeriodRecord, err error) {
+	match := isoPeriod.FindStringSubmatch(raw)
+	if match == nil || (match[2] == "" && match[3] == "" && match[4] == "" && match[5] == "") {
+		return p, fmt.Errorf("not an ISO-8601 period")
+	}
+
+	n := make([]int, 4)
+	for i := range n {
+		if match[i+2] == "" {
+			continue
+		}
+
+		if n[i], err = strconv.Atoi(match[i+2]); err != nil {
+			return p, err
+		}
+	}
+
+	p = graphson.PeriodRecord{Years: n[0], Months: n[1], Days: n[2]*7 + n[3]}
+	if match[1] == "-" {
+		p = graphson.PeriodRecord{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+	}
+
+	return p, nil
+}
+
+func (s GraphSONv3Serializer) writeExtended(buf *bytes.Buffer, in graphson.ValuePair) error {
+	return writeTyped(buf, extendedTypeNames[in.Type], func() error {
+		switch in.Type {
+		case graphson.BigDecimal:
+			f := in.Value.(*big.Float)
+			if f.IsInf() {
+				return graphson.ParsingError{Message: "gx:BigDecimal cannot be infinite", Operation: "serialize", Field: "@value"}
+			}
+
+			buf.WriteString(f.Text('f', -1))
+		case graphson.BigInteger:
+			buf.WriteString(in.Value.(*big.Int).String())
+		case graphson.Byte:
+			buf.WriteString(strconv.Itoa(int(in.Value.(int8))))
+		case graphson.Short:
+			buf.WriteString(strconv.Itoa(int(in.Value.(int16))))
+		case graphson.ByteBuffer:
+			return writeString(buf, base64.StdEncoding.EncodeToString(in.Value.([]byte)))
+		case graphson.Char:
+			return writeString(buf, string(in.Value.(rune)))
+		case graphson.Duration:
+			return writeString(buf, graphson.FormatDuration(in.Value.(time.Duration)))
+		case graphson.Instant:
+			return writeString(buf, in.Value.(time.Time).UTC().Format(time.RFC3339Nano))
+		case graphson.OffsetDateTime:
+			return writeString(buf, formatOffsetDateTime(in.Value.(time.Time)))
+		case graphson.ZonedDateTime:
+			return writeString(buf, formatZonedDateTime(in.Value.(time.Time)))
+		case graphson.Year:
+			return writeString(buf, strconv.Itoa(in.Value.(int)))
+		default:
+			// the remaining types are all records that know their own ISO-8601 form
+			return writeString(buf, in.Value.(fmt.Stringer).String())
+		}
+
+		return nil
+	})
+}
+
+func formatOffsetDateTime(t time.Time) string {
+	_, offset := t.Zone()
+	date := graphson.LocalDateRecord{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+	clock := graphson.LocalTimeRecord{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+
+	return date.String() + "T" + clock.String() + graphson.ZoneOffsetRecord{Seconds: offset}.String()
+}
+
+// formatZonedDateTime appends the time's location as the zone ID, leaving it off for the process local zone which has no
+// meaningful name outside of this machine
+func formatZonedDateTime(t time.Time) string {
+	out := formatOffsetDateTime(t)
+
+	if name := t.Location().String(); name != "" && name != "Local" {
+		out += "[" + name + "]"
+	}
+
+	return out
+}
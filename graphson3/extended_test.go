@@ -0,0 +1,158 @@
+package graphson3
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+// samples taken from http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_extended_2, each written back exactly as read
+var extended30 = []string{
+	`{"@type":"gx:BigDecimal","@value":123456789987654321123456789987654321}`,
+	`{"@type":"gx:BigDecimal","@value":0.1}`,
+	`{"@type":"gx:BigInteger","@value":123456789987654321123456789987654321}`,
+	`{"@type":"gx:Byte","@value":1}`,
+	`{"@type":"gx:ByteBuffer","@value":"c29tZSBieXRlcyBmb3IgeW91"}`,
+	`{"@type":"gx:Char","@value":"x"}`,
+	`{"@type":"gx:Duration","@value":"PT120H"}`,
+	`{"@type":"gx:Duration","@value":"PT8H6M12.345S"}`,
+	`{"@type":"gx:Instant","@value":"2016-12-14T16:39:19.349Z"}`,
+	`{"@type":"gx:LocalDate","@value":"2016-01-01"}`,
+	`{"@type":"gx:LocalDateTime","@value":"2016-01-01T12:30"}`,
+	`{"@type":"gx:LocalTime","@value":"12:30:45"}`,
+	`{"@type":"gx:MonthDay","@value":"--01-01"}`,
+	`{"@type":"gx:OffsetDateTime","@value":"2007-12-03T10:15:30+01:00"}`,
+	`{"@type":"gx:OffsetTime","@value":"10:15:30+01:00"}`,
+	`{"@type":"gx:Period","@value":"P1Y6M15D"}`,
+	`{"@type":"gx:Int16","@value":100}`,
+	`{"@type":"gx:Year","@value":"2016"}`,
+	`{"@type":"gx:YearMonth","@value":"2016-06"}`,
+	`{"@type":"gx:ZonedDateTime","@value":"2016-12-23T12:12:24.000000036+02:00[GMT+02:00]"}`,
+	`{"@type":"gx:ZoneOffset","@value":"+03:06:09"}`,
+}
+
+func TestExtendedParse(t *testing.T) {
+	g := GraphSONv3Parser{}
+
+	parse := func(in string) graphson.ValuePair {
+		vp, err := g.Parse([]byte(in))
+		assert.Nil(t, err, in)
+
+		return vp
+	}
+
+	expectedInt, _ := new(big.Int).SetString("123456789987654321123456789987654321", 10)
+	assert.Equal(t, 0, expectedInt.Cmp(parse(extended30[2]).AsBigInteger()))
+	assert.Equal(t, "0.1", parse(extended30[1]).AsBigDecimal().Text('g', -1))
+
+	assert.Equal(t, int8(1), parse(extended30[3]).AsByte())
+	assert.Equal(t, []byte("some bytes for you"), parse(extended30[4]).AsByteBuffer())
+	assert.Equal(t, 'x', parse(extended30[5]).AsChar())
+	assert.Equal(t, 120*time.Hour, parse(extended30[6]).AsDuration())
+	assert.Equal(t, 8*time.Hour+6*time.Minute+12345*time.Millisecond, parse(extended30[7]).AsDuration())
+	assert.Equal(t, time.Date(2016, 12, 14, 16, 39, 19, 349*int(time.Millisecond), time.UTC), parse(extended30[8]).AsInstant())
+	assert.Equal(t, graphson.LocalDateRecord{Year: 2016, Month: time.January, Day: 1}, parse(extended30[9]).AsLocalDate())
+	assert.Equal(t, graphson.LocalTimeRecord{Hour: 12, Minute: 30}, parse(extended30[10]).AsLocalDateTime().Time)
+	assert.Equal(t, graphson.LocalTimeRecord{Hour: 12, Minute: 30, Second: 45}, parse(extended30[11]).AsLocalTime())
+	assert.Equal(t, graphson.MonthDayRecord{Month: time.January, Day: 1}, parse(extended30[12]).AsMonthDay())
+
+	offsetDateTime := parse(extended30[13]).AsOffsetDateTime()
+	assert.True(t, time.Date(2007, 12, 3, 9, 15, 30, 0, time.UTC).Equal(offsetDateTime))
+
+	assert.Equal(t, 3600, parse(extended30[14]).AsOffsetTime().Offset.Seconds)
+	assert.Equal(t, graphson.PeriodRecord{Years: 1, Months: 6, Days: 15}, parse(extended30[15]).AsPeriod())
+	assert.Equal(t, int16(100), parse(extended30[16]).AsShort())
+	assert.Equal(t, 2016, parse(extended30[17]).AsYear())
+	assert.Equal(t, graphson.YearMonthRecord{Year: 2016, Month: time.June}, parse(extended30[18]).AsYearMonth())
+
+	zoned := parse(extended30[19]).AsZonedDateTime()
+	assert.Equal(t, 36, zoned.Nanosecond())
+	assert.True(t, time.Date(2016, 12, 23, 10, 12, 24, 36, time.UTC).Equal(zoned))
+
+	assert.Equal(t, graphson.ZoneOffsetRecord{Seconds: 3*3600 + 6*60 + 9}, parse(extended30[20]).AsZoneOffset())
+}
+
+func TestExtendedParseInvalid(t *testing.T) {
+	g := GraphSONv3Parser{}
+
+	for _, in := range []string{
+		`{"@type":"gx:Byte","@value":300}`,
+		`{"@type":"gx:Char","@value":"xy"}`,
+		`{"@type":"gx:Duration","@value":"PT"}`,
+		`{"@type":"gx:Period","@value":"P"}`,
+		`{"@type":"gx:LocalDate","@value":"2016-13-01"}`,
+		`{"@type":"gx:BigInteger","@value":1.5}`,
+	} {
+		_, err := g.Parse([]byte(in))
+		assert.NotNil(t, err, in)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"PT0S":     0,
+		"PT-6H3M":  -6*time.Hour + 3*time.Minute,
+		"-PT6H3M":  -(6*time.Hour + 3*time.Minute),
+		"PT-0.5S":  -500 * time.Millisecond,
+		"P2DT3H":   51 * time.Hour,
+		"PT1M30S":  90 * time.Second,
+		"PT0.001S": time.Millisecond,
+	}
+
+	for in, expected := range cases {
+		d, err := parseDuration(in)
+		assert.Nil(t, err, in)
+		assert.Equal(t, expected, d, in)
+	}
+
+	assert.Equal(t, "PT-1M-30S", graphson.FormatDuration(-90*time.Second))
+	assert.Equal(t, "PT-0.5S", graphson.FormatDuration(-500*time.Millisecond))
+}
+
+func TestExtendedRoundTrip(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	for _, in := range extended30 {
+		original, err := g.Parse([]byte(in))
+		assert.Nil(t, err, in)
+
+		out, err := s.Serialize(original)
+		assert.Nil(t, err, in)
+		assert.Equal(t, in, string(out))
+	}
+}
+
+func TestBigDecimalRoundTrip(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	for _, decimal := range []string{"0.1", "0.3", "-2.675", "0.000000000000000000000000000001", "3.14159265358979323846264338327950288419716939937510582097494459"} {
+		in := `{"@type":"gx:BigDecimal","@value":` + decimal + `}`
+
+		vp, err := g.Parse([]byte(in))
+		assert.Nil(t, err, decimal)
+
+		out, err := s.Serialize(vp)
+		assert.Nil(t, err, decimal)
+		assert.Equal(t, in, string(out))
+	}
+
+	// the value itself is the nearest binary fraction rather than the decimal
+	vp, err := g.Parse([]byte(`{"@type":"gx:BigDecimal","@value":0.1}`))
+	assert.Nil(t, err)
+
+	r, _ := vp.AsBigDecimal().Rat(nil)
+	assert.NotEqual(t, 0, r.Cmp(big.NewRat(1, 10)))
+
+	// trailing zeros aren't kept
+	vp, err = g.Parse([]byte(`{"@type":"gx:BigDecimal","@value":1.50}`))
+	assert.Nil(t, err)
+
+	out, err := s.Serialize(vp)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"@type":"gx:BigDecimal","@value":1.5}`, string(out))
+}
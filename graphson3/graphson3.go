@@ -9,7 +9,21 @@ import (
 	"github.com/buger/jsonparser"
 )
 
-type GraphSONv3Parser struct{}
+const traverserTypeName = "g:Traverser"
+
+type GraphSONv3Parser struct {
+	// UnwrapTraversers replaces each g:Traverser found in a g:List or g:Set with its value, repeated once per bulk, so
+	// that the results of bytecode requests can be iterated the same way as those of script requests.
+	UnwrapTraversers bool
+
+	// Neptune normalizes two ways Amazon Neptune's GraphSON 3 differs from TinkerPop's, and nothing else. g:Date values
+	// are read in the epoch precision their magnitude suggests, seconds through nanoseconds, rather than always as
+	// milliseconds, and string map keys naming a T token, such as "T.id" and "T.label", are read as the g:T tokens
+	// TinkerPop writes in their place. The precision is a guess: anything below 1e11 is taken as seconds, so millisecond
+	// dates before 1973-03-03T09:46:40Z are misread. Neptune's untyped string IDs and edges without vertex labels need no
+	// option, the parser always accepts them.
+	Neptune bool
+}
 
 func parsedToType(in []byte, vt jsonparser.ValueType) (interface{}, error) {
 
@@ -41,6 +55,36 @@ func parsedToType(in []byte, vt jsonparser.ValueType) (interface{}, error) {
 	return in, nil
 }
 
+// elementID parses an element's ID, keeping the GraphSON type it was written with so that it can be sent back in a
+// binding as it is. Untyped IDs, such as the string IDs Neptune writes, are typed from their JSON shape.
+func (g GraphSONv3Parser) elementID(value []byte, vt jsonparser.ValueType) (graphson.ValuePair, error) {
+	switch vt {
+	case jsonparser.Object:
+		return g.Parse(value)
+	case jsonparser.String:
+		id, err := jsonparser.ParseString(value)
+		return graphson.ValuePair{Type: graphson.String, Value: id}, err
+	case jsonparser.Null: // not yet assigned an ID
+		return graphson.ValuePair{}, nil
+	}
+
+	id, err := parsedToType(value, vt)
+	if err != nil {
+		return graphson.ValuePair{}, err
+	}
+
+	switch id.(type) {
+	case int64:
+		return graphson.ValuePair{Type: graphson.Int64, Value: id}, nil
+	case float64:
+		return graphson.ValuePair{Type: graphson.Double, Value: id}, nil
+	case bool:
+		return graphson.ValuePair{Type: graphson.Boolean, Value: id}, nil
+	}
+
+	return graphson.ValuePair{}, errors.New("unknown type or invalid data")
+}
+
 // getValueType examines a GraphSON 3 value/type pair and returns the correct value
 func getValueType(in []byte) (graphson.ValueType, error) {
 	typeName, err := jsonparser.GetString(in, "@type")
@@ -80,15 +124,63 @@ func valueTypeFromString(raw string) graphson.ValueType {
 		return graphson.Timestamp
 	case "g:Set":
 		return graphson.Set
+	case "g:BulkSet":
+		return graphson.BulkSet
+	case traverserTypeName:
+		return graphson.Traverser
+	case traversalMetricsTypeName:
+		return graphson.TraversalMetrics
+	case metricsTypeName:
+		return graphson.Metrics
+	case traversalExplanationTypeName:
+		return graphson.TraversalExplanation
+	case "g:T":
+		return graphson.T
+	case "g:Direction":
+		return graphson.Direction
+	case "g:Cardinality":
+		return graphson.Cardinality
+	case "g:Order":
+		return graphson.Order
+	case "g:Pop":
+		return graphson.Pop
+	case "g:Scope":
+		return graphson.Scope
+	case "g:Column":
+		return graphson.Column
+	case "g:Barrier":
+		return graphson.Barrier
+	case "g:Operator":
+		return graphson.Operator
+	case "g:Pick":
+		return graphson.Pick
 	case "g:UUID":
 		return graphson.UUID
-	case "g:Vertex":
+	case vertexTypeName, legacyVertexTypeName:
 		return graphson.Vertex
-	default:
-		return graphson.Unknown
+	case vertexPropertyTypeName, legacyVertexPropertyTypeName:
+		return graphson.VertexProperty
+	case edgeTypename:
+		return graphson.Edge
+	case propertyTypeName:
+		return graphson.EdgeProperty
+	case pathTypeName:
+		return graphson.Path
+	case treeTypeName:
+		return graphson.Tree
 	}
+
+	for vt, name := range extendedTypeNames {
+		if name == raw {
+			return vt
+		}
+	}
+
+	return graphson.Unknown
 }
 
 func init() {
 	graphson.RegisterParser("v3", GraphSONv3Parser{})
+	graphson.RegisterParser("neptune", GraphSONv3Parser{Neptune: true})
+	graphson.RegisterSerializer("v3", GraphSONv3Serializer{})
 }
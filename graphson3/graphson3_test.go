@@ -240,3 +240,312 @@ const uuid30 = `{
   "@type" : "g:UUID",
   "@value" : "41d2e28a-20a4-4ab0-b379-d810dede3786"
 }`
+
+const path30 = `{
+  "@type" : "g:Path",
+  "@value" : {
+    "labels" : {
+      "@type" : "g:List",
+      "@value" : [ {
+        "@type" : "g:Set",
+        "@value" : [ "a" ]
+      }, {
+        "@type" : "g:Set",
+        "@value" : [ ]
+      }, {
+        "@type" : "g:Set",
+        "@value" : [ "c", "d" ]
+      } ]
+    },
+    "objects" : {
+      "@type" : "g:List",
+      "@value" : [ {
+        "@type" : "g:Vertex",
+        "@value" : {
+          "id" : {
+            "@type" : "g:Int32",
+            "@value" : 1
+          },
+          "label" : "person"
+        }
+      }, {
+        "@type" : "g:Vertex",
+        "@value" : {
+          "id" : {
+            "@type" : "g:Int32",
+            "@value" : 10
+          },
+          "label" : "software"
+        }
+      }, "gremlin" ]
+    }
+  }
+}`
+
+const tree30 = `{
+  "@type" : "g:Tree",
+  "@value" : [ {
+    "key" : {
+      "@type" : "g:Vertex",
+      "@value" : {
+        "id" : {
+          "@type" : "g:Int32",
+          "@value" : 1
+        },
+        "label" : "person"
+      }
+    },
+    "value" : {
+      "@type" : "g:Tree",
+      "@value" : [ {
+        "key" : {
+          "@type" : "g:Vertex",
+          "@value" : {
+            "id" : {
+              "@type" : "g:Int32",
+              "@value" : 10
+            },
+            "label" : "software"
+          }
+        },
+        "value" : {
+          "@type" : "g:Tree",
+          "@value" : [ {
+            "key" : "gremlin",
+            "value" : {
+              "@type" : "g:Tree",
+              "@value" : [ ]
+            }
+          } ]
+        }
+      }, {
+        "key" : {
+          "@type" : "g:Vertex",
+          "@value" : {
+            "id" : {
+              "@type" : "g:Int32",
+              "@value" : 11
+            },
+            "label" : "software"
+          }
+        },
+        "value" : {
+          "@type" : "g:Tree",
+          "@value" : [ ]
+        }
+      } ]
+    }
+  } ]
+}`
+
+const bulkSet30 = `{
+  "@type" : "g:BulkSet",
+  "@value" : [ "marko", {
+    "@type" : "g:Int64",
+    "@value" : 1
+  }, "josh", {
+    "@type" : "g:Int64",
+    "@value" : 2
+  } ]
+}`
+
+const traversers30 = `{
+  "@type" : "g:List",
+  "@value" : [ {
+    "@type" : "g:Traverser",
+    "@value" : {
+      "bulk" : {
+        "@type" : "g:Int64",
+        "@value" : 2
+      },
+      "value" : "marko"
+    }
+  }, {
+    "@type" : "g:Traverser",
+    "@value" : {
+      "bulk" : {
+        "@type" : "g:Int64",
+        "@value" : 1
+      },
+      "value" : {
+        "@type" : "g:Int32",
+        "@value" : 29
+      }
+    }
+  } ]
+}`
+
+const traversalMetrics30 = `{
+  "@type" : "g:TraversalMetrics",
+  "@value" : {
+    "@type" : "g:Map",
+    "@value" : [ "dur", {
+      "@type" : "g:Double",
+      "@value" : 0.5
+    }, "metrics", {
+      "@type" : "g:List",
+      "@value" : [ {
+        "@type" : "g:Metrics",
+        "@value" : {
+          "@type" : "g:Map",
+          "@value" : [ "dur", {
+            "@type" : "g:Double",
+            "@value" : 0.25
+          }, "counts", {
+            "@type" : "g:Map",
+            "@value" : [ "traverserCount", {
+              "@type" : "g:Int64",
+              "@value" : 4
+            }, "elementCount", {
+              "@type" : "g:Int64",
+              "@value" : 4
+            } ]
+          }, "name", "TinkerGraphStep(vertex,[~label.eq(person)])", "annotations", {
+            "@type" : "g:Map",
+            "@value" : [ "percentDur", {
+              "@type" : "g:Double",
+              "@value" : 50.0
+            } ]
+          }, "id", "7.0.0()" ]
+        }
+      }, {
+        "@type" : "g:Metrics",
+        "@value" : {
+          "@type" : "g:Map",
+          "@value" : [ "dur", {
+            "@type" : "g:Double",
+            "@value" : 0.25
+          }, "counts", {
+            "@type" : "g:Map",
+            "@value" : [ "traverserCount", {
+              "@type" : "g:Int64",
+              "@value" : 2
+            }, "elementCount", {
+              "@type" : "g:Int64",
+              "@value" : 2
+            } ]
+          }, "name", "VertexStep(OUT,[created],vertex)", "annotations", {
+            "@type" : "g:Map",
+            "@value" : [ "percentDur", {
+              "@type" : "g:Double",
+              "@value" : 50.0
+            } ]
+          }, "id", "2.0.0()", "metrics", {
+            "@type" : "g:List",
+            "@value" : [ {
+              "@type" : "g:Metrics",
+              "@value" : {
+                "@type" : "g:Map",
+                "@value" : [ "dur", {
+                  "@type" : "g:Double",
+                  "@value" : 0.125
+                }, "counts", {
+                  "@type" : "g:Map",
+                  "@value" : [ ]
+                }, "name", "ExpandableStepIterator@1", "annotations", {
+                  "@type" : "g:Map",
+                  "@value" : [ ]
+                }, "id", "2.0.0(1)" ]
+              }
+            } ]
+          } ]
+        }
+      } ]
+    } ]
+  }
+}`
+
+const traversalExplanation30 = `{
+  "@type" : "g:TraversalExplanation",
+  "@value" : {
+    "@type" : "g:Map",
+    "@value" : [ "original", {
+      "@type" : "g:List",
+      "@value" : [ "GraphStep(vertex,[])", "VertexStep(OUT,edge)", "EdgeVertexStep(IN)" ]
+    }, "intermediate", {
+      "@type" : "g:List",
+      "@value" : [ {
+        "@type" : "g:Map",
+        "@value" : [ "strategy", "ConnectiveStrategy", "category", "DecorationStrategy", "traversal", {
+          "@type" : "g:List",
+          "@value" : [ "GraphStep(vertex,[])", "VertexStep(OUT,edge)", "EdgeVertexStep(IN)" ]
+        } ]
+      }, {
+        "@type" : "g:Map",
+        "@value" : [ "strategy", "IncidentToAdjacentStrategy", "category", "OptimizationStrategy", "traversal", {
+          "@type" : "g:List",
+          "@value" : [ "GraphStep(vertex,[])", "VertexStep(OUT,vertex)" ]
+        } ]
+      }, {
+        "@type" : "g:Map",
+        "@value" : [ "strategy", "TinkerGraphStepStrategy", "category", "ProviderOptimizationStrategy", "traversal", {
+          "@type" : "g:List",
+          "@value" : [ "TinkerGraphStep(vertex,[])", "VertexStep(OUT,vertex)" ]
+        } ]
+      } ]
+    }, "final", {
+      "@type" : "g:List",
+      "@value" : [ "TinkerGraphStep(vertex,[])", "VertexStep(OUT,vertex)" ]
+    } ]
+  }
+}`
+
+const traversalExplanationObject30 = `{
+  "@type" : "g:TraversalExplanation",
+  "@value" : {
+    "original" : [ "GraphStep(vertex,[])", "VertexStep(OUT,edge)", "EdgeVertexStep(IN)" ],
+    "intermediate" : [ {
+      "strategy" : "IncidentToAdjacentStrategy",
+      "category" : "OptimizationStrategy",
+      "traversal" : [ "GraphStep(vertex,[])", "VertexStep(OUT,vertex)" ]
+    } ],
+    "final" : [ "GraphStep(vertex,[])", "VertexStep(OUT,vertex)" ]
+  }
+}`
+
+const elementMap30 = `{
+  "@type" : "g:Map",
+  "@value" : [ {
+    "@type" : "g:T",
+    "@value" : "id"
+  }, {
+    "@type" : "g:Int32",
+    "@value" : 13
+  }, {
+    "@type" : "g:T",
+    "@value" : "label"
+  }, "develops", {
+    "@type" : "g:Direction",
+    "@value" : "IN"
+  }, {
+    "@type" : "g:Map",
+    "@value" : [ {
+      "@type" : "g:T",
+      "@value" : "id"
+    }, {
+      "@type" : "g:Int32",
+      "@value" : 10
+    }, {
+      "@type" : "g:T",
+      "@value" : "label"
+    }, "software" ]
+  }, {
+    "@type" : "g:Direction",
+    "@value" : "OUT"
+  }, {
+    "@type" : "g:Map",
+    "@value" : [ {
+      "@type" : "g:T",
+      "@value" : "id"
+    }, {
+      "@type" : "g:Int32",
+      "@value" : 1
+    }, {
+      "@type" : "g:T",
+      "@value" : "label"
+    }, "person" ]
+  }, "since", {
+    "@type" : "g:Int32",
+    "@value" : 2009
+  } ]
+}`
@@ -0,0 +1,57 @@
+package graphson3
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+// the field mapping itself is tested in the root package, these check that marshalled records survive serialization
+
+func TestMarshalVertex(t *testing.T) {
+	endTime := 2001
+	person := testPerson{
+		ID:    1,
+		Label: "person",
+		Name:  "marko",
+		Locations: []testLocation{
+			{Name: "san diego", StartTime: 1997, EndTime: &endTime},
+			{Name: "santa fe", StartTime: 2005},
+		},
+	}
+
+	vertex, err := graphson.MarshalVertex(person)
+	assert.Nil(t, err)
+
+	out, err := GraphSONv3Serializer{}.SerializeVertex(vertex)
+	assert.Nil(t, err)
+
+	reparsed, err := GraphSONv3Parser{}.Parse(out)
+	assert.Nil(t, err)
+	assert.Equal(t, vertex, reparsed.AsVertex())
+
+	unmarshalled := testPerson{}
+	assert.Nil(t, graphson.Unmarshal(reparsed, &unmarshalled))
+
+	unmarshalled.Places = nil
+	assert.Equal(t, person, unmarshalled)
+}
+
+func TestMarshalEdge(t *testing.T) {
+	edge, err := graphson.MarshalEdge(struct {
+		Label string `graphson:"label"`
+		In    int32  `graphson:"inV"`
+		Out   int32  `graphson:"outV"`
+		Since int    `graphson:"since"`
+	}{"develops", 10, 1, 2009})
+	assert.Nil(t, err)
+
+	out, err := GraphSONv3Serializer{}.SerializeEdge(edge)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"@type":"g:Edge","@value":{"id":null,"label":"develops","inVLabel":"","outVLabel":"","inV":{"@type":"g:Int32","@value":10},"outV":{"@type":"g:Int32","@value":1},"properties":{"since":{"@type":"g:Property","@value":{"key":"since","value":{"@type":"g:Int32","@value":2009}}}}}}`, string(out))
+
+	reparsed, err := GraphSONv3Parser{}.ParseEdge(out)
+	assert.Nil(t, err)
+	assert.Equal(t, edge, reparsed)
+}
@@ -0,0 +1,235 @@
+package graphson3
+
+import (
+	"bytes"
+	"math"
+	"time"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+const traversalMetricsTypeName = "g:TraversalMetrics"
+const metricsTypeName = "g:Metrics"
+
+// parseTraversalMetrics expects the input to be valid JSON and to be a single TraversalMetrics record, the result of a
+// profile() step. See http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_traversalmetrics_3.
+func (g GraphSONv3Parser) parseTraversalMetrics(in []byte) (metrics graphson.TraversalMetricsRecord, err error) {
+	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || typeName != traversalMetricsTypeName {
+		return metrics, graphson.ParsingError{Message: "provided input not a g:TraversalMetrics type", Operation: "parseTraversalMetrics", Field: "@type"}
+	}
+
+	value, _, _, err := jsonparser.Get(in, "@value")
+	if err != nil {
+		return metrics, graphson.ParsingError{Message: err.Error(), Operation: "parseTraversalMetrics", Field: "@value"}
+	}
+
+	err = g.eachMapEntry(value, func(key graphson.ValuePair, value []byte) error {
+		switch key.AsString() {
+		case "dur":
+			metrics.Duration, err = g.parseMillis(value)
+			return err
+
+		case "metrics":
+			metrics.Metrics, err = g.parseMetricsList(value)
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return metrics, graphson.ParsingError{Message: err.Error(), Operation: "parseTraversalMetrics", Field: "@value"}
+	}
+
+	return metrics, nil
+}
+
+// parseMetrics expects the input to be valid JSON and to be a single Metrics record. See
+// http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_metrics_3.
+func (g GraphSONv3Parser) parseMetrics(in []byte) (metrics graphson.MetricsRecord, err error) {
+	metrics.Counts = map[string]int64{}
+	metrics.Annotations = map[string]graphson.ValuePair{}
+
+	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || typeName != metricsTypeName {
+		return metrics, graphson.ParsingError{Message: "provided input not a g:Metrics type", Operation: "parseMetrics", Field: "@type"}
+	}
+
+	value, _, _, err := jsonparser.Get(in, "@value")
+	if err != nil {
+		return metrics, graphson.ParsingError{Message: err.Error(), Operation: "parseMetrics", Field: "@value"}
+	}
+
+	err = g.eachMapEntry(value, func(key graphson.ValuePair, value []byte) error {
+		switch key.AsString() {
+		case "id":
+			metrics.ID, err = jsonparser.ParseString(value)
+			return err
+
+		case "name":
+			metrics.Name, err = jsonparser.ParseString(value)
+			return err
+
+		case "dur":
+			metrics.Duration, err = g.parseMillis(value)
+			return err
+
+		case "counts":
+			return g.eachMapEntry(value, func(key graphson.ValuePair, value []byte) error {
+				count, err := g.Parse(value)
+				if err != nil {
+					return err
+				}
+
+				switch count.Type {
+				case graphson.Int64:
+					metrics.Counts[key.AsString()] = count.AsInt64()
+				case graphson.Int32:
+					metrics.Counts[key.AsString()] = int64(count.AsInt32())
+				default:
+					return graphson.ParsingError{Message: "metrics count not an integer", Operation: "parseMetrics", Field: "counts"}
+				}
+
+				return nil
+			})
+
+		case "annotations":
+			return g.eachMapEntry(value, func(key graphson.ValuePair, value []byte) error {
+				annotation, err := g.Parse(value)
+				metrics.Annotations[key.AsString()] = annotation
+
+				return err
+			})
+
+		case "metrics":
+			metrics.Metrics, err = g.parseMetricsList(value)
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return metrics, graphson.ParsingError{Message: err.Error(), Operation: "parseMetrics", Field: "@value"}
+	}
+
+	return metrics, nil
+}
+
+func (g GraphSONv3Parser) parseMetricsList(in []byte) ([]graphson.MetricsRecord, error) {
+	list, err := g.parseSet(in)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]graphson.MetricsRecord, 0, len(list))
+	for _, vp := range list {
+		if vp.Type != graphson.Metrics {
+			return nil, graphson.ParsingError{Message: "provided input not a g:Metrics type", Operation: "parseMetrics", Field: "metrics"}
+		}
+
+		out = append(out, vp.AsMetrics())
+	}
+
+	return out, nil
+}
+
+// parseMillis reads the fractional milliseconds profile() reports durations in. The number is read straight from the
+// @value at full float64 precision, whichever numeric type it was written as.
+func (g GraphSONv3Parser) parseMillis(in []byte) (time.Duration, error) {
+	vt, err := getValueType(in)
+	if err != nil {
+		return 0, err
+	}
+
+	switch vt {
+	case graphson.Double, graphson.Float, graphson.Int64, graphson.Int32:
+	default:
+		return 0, graphson.ParsingError{Message: "duration not a number", Operation: "parseMillis", Field: "dur"}
+	}
+
+	millis, err := jsonparser.GetFloat(in, "@value")
+	if err != nil {
+		return 0, graphson.ParsingError{Message: err.Error(), Operation: "parseMillis", Field: "dur"}
+	}
+
+	return time.Duration(math.Round(millis * float64(time.Millisecond))), nil
+}
+
+func (s GraphSONv3Serializer) writeTraversalMetrics(buf *bytes.Buffer, in graphson.TraversalMetricsRecord) error {
+	return writeTyped(buf, traversalMetricsTypeName, func() error {
+		return writeTyped(buf, "g:Map", func() error {
+			return s.writeStringMap(buf, []string{"dur", "metrics"}, func(key string) error {
+				if key == "dur" {
+					return writeMillisDuration(buf, in.Duration)
+				}
+
+				return s.writeMetricsList(buf, in.Metrics)
+			})
+		})
+	})
+}
+
+func (s GraphSONv3Serializer) writeMetrics(buf *bytes.Buffer, in graphson.MetricsRecord) error {
+	keys := []string{"dur", "counts", "name", "annotations", "id"}
+	if len(in.Metrics) > 0 {
+		keys = append(keys, "metrics")
+	}
+
+	return writeTyped(buf, metricsTypeName, func() error {
+		return writeTyped(buf, "g:Map", func() error {
+			return s.writeStringMap(buf, keys, func(key string) error {
+				switch key {
+				case "dur":
+					return writeMillisDuration(buf, in.Duration)
+				case "counts":
+					counts := make(map[string]graphson.ValuePair, len(in.Counts))
+					for name, count := range in.Counts {
+						counts[name] = graphson.ValuePair{Type: graphson.Int64, Value: count}
+					}
+
+					return writeTyped(buf, "g:Map", func() error {
+						return s.writeStringMap(buf, sortedKeys(counts), func(key string) error { return s.writeValuePair(buf, counts[key]) })
+					})
+				case "name":
+					return writeString(buf, in.Name)
+				case "annotations":
+					return writeTyped(buf, "g:Map", func() error {
+						return s.writeStringMap(buf, sortedKeys(in.Annotations), func(key string) error { return s.writeValuePair(buf, in.Annotations[key]) })
+					})
+				case "id":
+					return writeString(buf, in.ID)
+				default:
+					return s.writeMetricsList(buf, in.Metrics)
+				}
+			})
+		})
+	})
+}
+
+func (s GraphSONv3Serializer) writeMetricsList(buf *bytes.Buffer, in []graphson.MetricsRecord) error {
+	return writeTyped(buf, "g:List", func() error {
+		buf.WriteByte('[')
+
+		for i, metrics := range in {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := s.writeMetrics(buf, metrics); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte(']')
+
+		return nil
+	})
+}
+
+func writeMillisDuration(buf *bytes.Buffer, in time.Duration) error {
+	return writeTyped(buf, "g:Double", func() error {
+		return writeFloat(buf, float64(in)/float64(time.Millisecond), 64)
+	})
+}
@@ -0,0 +1,79 @@
+package graphson3
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTraversalMetrics(t *testing.T) {
+	g := GraphSONv3Parser{}
+	vp, err := g.Parse([]byte(traversalMetrics30))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.TraversalMetrics, vp.Type)
+
+	metrics := vp.AsTraversalMetrics()
+	assert.Equal(t, 500*time.Microsecond, metrics.Duration)
+	assert.Len(t, metrics.Metrics, 2)
+
+	step := metrics.Metrics[0]
+	assert.Equal(t, "7.0.0()", step.ID)
+	assert.Equal(t, "TinkerGraphStep(vertex,[~label.eq(person)])", step.Name)
+	assert.Equal(t, 250*time.Microsecond, step.Duration)
+	assert.Equal(t, int64(4), step.Counts[graphson.MetricsElementCount])
+	assert.Equal(t, float64(50), step.Annotations[graphson.MetricsPercentDur].AsFloat64())
+
+	nested := metrics.Metrics[1].Metrics
+	assert.Len(t, nested, 1)
+	assert.Equal(t, "ExpandableStepIterator@1", nested[0].Name)
+	assert.Equal(t, 125*time.Microsecond, nested[0].Duration)
+}
+
+func TestParseMillisPrecision(t *testing.T) {
+	g := GraphSONv3Parser{}
+
+	// a float32 holds 16777.217 as 16777.216796875
+	d, err := g.parseMillis([]byte(`{"@type":"g:Double","@value":16777.217}`))
+	assert.Nil(t, err)
+	assert.Equal(t, 16777217*time.Microsecond, d)
+
+	d, err = g.parseMillis([]byte(`{"@type":"g:Int64","@value":3}`))
+	assert.Nil(t, err)
+	assert.Equal(t, 3*time.Millisecond, d)
+
+	_, err = g.parseMillis([]byte(`"3"`))
+	assert.NotNil(t, err)
+}
+
+func TestTraversalMetricsTable(t *testing.T) {
+	g := GraphSONv3Parser{}
+	vp, err := g.Parse([]byte(traversalMetrics30))
+	assert.Nil(t, err)
+
+	lines := strings.Split(strings.TrimRight(vp.AsTraversalMetrics().String(), "\n"), "\n")
+	assert.Len(t, lines, 7)
+	assert.Equal(t, "Traversal Metrics", lines[0])
+	assert.True(t, strings.HasPrefix(lines[3], "TinkerGraphStep(vertex,[~label.eq(person)])"))
+	assert.True(t, strings.HasSuffix(lines[3], "4            4            0.250     50.00"))
+	assert.True(t, strings.HasPrefix(lines[5], "  ExpandableStepIterator@1"))
+	assert.Contains(t, lines[6], ">TOTAL")
+	assert.True(t, strings.HasSuffix(lines[6], "0.500         -"))
+}
+
+func TestSerializeTraversalMetrics(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	original, err := g.Parse([]byte(traversalMetrics30))
+	assert.Nil(t, err)
+
+	out, err := s.Serialize(original)
+	assert.Nil(t, err)
+
+	reparsed, err := g.Parse(out)
+	assert.Nil(t, err)
+	assert.Equal(t, original, reparsed)
+}
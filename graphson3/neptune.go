@@ -0,0 +1,43 @@
+package graphson3
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dnoberon/graphson"
+)
+
+// neptuneDate reads an epoch timestamp of unknown precision. The precision is picked from the magnitude of the value,
+// below 1e11 seconds, then milliseconds, microseconds and nanoseconds from 1e11, 1e14 and 1e17. Millisecond dates before
+// 1e11, 1973-03-03T09:46:40Z, are misread as seconds; a trade off for reading second precision dates at all.
+func neptuneDate(value int64) time.Time {
+	magnitude := value
+	if magnitude < 0 {
+		magnitude = -magnitude
+	}
+
+	switch {
+	case magnitude < 1e11:
+		return time.Unix(value, 0)
+	case magnitude < 1e14:
+		return time.Unix(value/1e3, (value%1e3)*int64(time.Millisecond))
+	case magnitude < 1e17:
+		return time.Unix(value/1e6, (value%1e6)*int64(time.Microsecond))
+	}
+
+	return time.Unix(0, value)
+}
+
+// neptuneKey replaces a string map key naming a T token, e.g. "T.id", with the token itself
+func neptuneKey(key graphson.ValuePair) graphson.ValuePair {
+	if key.Type != graphson.String || !strings.HasPrefix(key.AsString(), "T.") {
+		return key
+	}
+
+	switch token := graphson.TToken(strings.TrimPrefix(key.AsString(), "T.")); token {
+	case graphson.TID, graphson.TLabel, graphson.TKey, graphson.TValue:
+		return graphson.ValuePair{Type: graphson.T, Value: token}
+	}
+
+	return key
+}
@@ -0,0 +1,182 @@
+package graphson3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+// hand-written samples in the shape of Amazon Neptune responses, reduced to the parts that differ from TinkerPop
+
+const neptuneVertices = `{
+  "requestId" : "7d4c3f1e-2b5a-4e8f-9a6d-1c0b3e5f7a92",
+  "status" : {
+    "message" : "",
+    "code" : 200,
+    "attributes" : {
+      "@type" : "g:Map",
+      "@value" : [ ]
+    }
+  },
+  "result" : {
+    "data" : {
+      "@type" : "g:List",
+      "@value" : [ {
+        "@type" : "g:Vertex",
+        "@value" : {
+          "id" : "b6c0f1a2-6f1e-4d1c-9b7e-3a2f5c8d0e14",
+          "label" : "person",
+          "properties" : {
+            "name" : [ {
+              "@type" : "g:VertexProperty",
+              "@value" : {
+                "id" : {
+                  "@type" : "g:Int32",
+                  "@value" : -1374148432
+                },
+                "value" : "marko",
+                "label" : "name"
+              }
+            } ]
+          }
+        }
+      } ]
+    },
+    "meta" : {
+      "@type" : "g:Map",
+      "@value" : [ ]
+    }
+  }
+}`
+
+const neptuneEdge = `{
+  "@type" : "g:Edge",
+  "@value" : {
+    "id" : "4ec0f1a2-1d2e-3f4a-5b6c-7d8e9f0a1b2c",
+    "label" : "knows",
+    "inV" : "v2",
+    "outV" : "v1",
+    "properties" : {
+      "weight" : {
+        "@type" : "g:Property",
+        "@value" : {
+          "key" : "weight",
+          "value" : {
+            "@type" : "g:Double",
+            "@value" : 0.5
+          }
+        }
+      }
+    }
+  }
+}`
+
+const neptuneElementMap = `{
+  "@type" : "g:Map",
+  "@value" : [ "T.id", "v1", "T.label", "person", "name", "marko", "T.other", "kept" ]
+}`
+
+const neptuneDateSeconds = `{
+  "@type" : "g:Date",
+  "@value" : 1481750076
+}`
+
+const neptuneDateMillis = `{
+  "@type" : "g:Date",
+  "@value" : 1481750076295
+}`
+
+// Neptune's string IDs and edges without vertex labels are read by the plain parser, the option plays no part in them
+
+func TestParseNeptuneStringIDs(t *testing.T) {
+	g := GraphSONv3Parser{}
+
+	response, err := g.ParseResponse([]byte(neptuneVertices))
+	assert.Nil(t, err)
+
+	vertices := response.Data.Value.([]graphson.ValuePair)
+	assert.Len(t, vertices, 1)
+
+	vertex := vertices[0].AsVertex()
+	assert.Equal(t, graphson.ValuePair{Type: graphson.String, Value: "b6c0f1a2-6f1e-4d1c-9b7e-3a2f5c8d0e14"}, vertex.ID)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: -1374148432}, vertex.Properties["name"][0].ID)
+	assert.Equal(t, "marko", vertex.Properties["name"][0].Value.AsString())
+}
+
+func TestParseNeptuneEdgeMissingLabels(t *testing.T) {
+	g := GraphSONv3Parser{}
+
+	edge, err := g.ParseEdge([]byte(neptuneEdge))
+	assert.Nil(t, err)
+
+	assert.Equal(t, graphson.ValuePair{Type: graphson.String, Value: "4ec0f1a2-1d2e-3f4a-5b6c-7d8e9f0a1b2c"}, edge.ID)
+	assert.Equal(t, "v2", edge.InV.AsString())
+	assert.Equal(t, "v1", edge.OutV.AsString())
+	assert.Equal(t, "", edge.InVLabel)
+	assert.Equal(t, "", edge.OutVLabel)
+
+	out, err := GraphSONv3Serializer{}.SerializeEdge(edge)
+	assert.Nil(t, err)
+
+	reparsed, err := g.ParseEdge(out)
+	assert.Nil(t, err)
+	assert.Equal(t, edge, reparsed)
+}
+
+func TestNeptuneTokenKeys(t *testing.T) {
+	vp, err := GraphSONv3Parser{Neptune: true}.Parse([]byte(neptuneElementMap))
+	assert.Nil(t, err)
+
+	m := vp.AsMap()
+	assert.Equal(t, graphson.ValuePair{Type: graphson.T, Value: graphson.TID}, m.Entries[0].Key)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.T, Value: graphson.TLabel}, m.Entries[1].Key)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.String, Value: "T.other"}, m.Entries[3].Key)
+
+	id, ok := m.Lookup(graphson.ValuePair{Type: graphson.T, Value: graphson.TID})
+	assert.True(t, ok)
+	assert.Equal(t, "v1", id.AsString())
+
+	// without the option the keys are left as written
+	vp, err = GraphSONv3Parser{}.Parse([]byte(neptuneElementMap))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.String, vp.AsMap().Entries[0].Key.Type)
+}
+
+func TestNeptuneDatePrecision(t *testing.T) {
+	g := GraphSONv3Parser{Neptune: true}
+	expected := time.Unix(1481750076, 0)
+
+	seconds, err := g.Parse([]byte(neptuneDateSeconds))
+	assert.Nil(t, err)
+	assert.True(t, expected.Equal(seconds.Value.(time.Time)))
+
+	millis, err := g.Parse([]byte(neptuneDateMillis))
+	assert.Nil(t, err)
+	assert.True(t, expected.Add(295*time.Millisecond).Equal(millis.Value.(time.Time)))
+
+	assert.True(t, expected.Equal(neptuneDate(1481750076000000)))
+	assert.True(t, expected.Equal(neptuneDate(1481750076000000000)))
+
+	// without the option every date is milliseconds
+	seconds, err = GraphSONv3Parser{}.Parse([]byte(neptuneDateSeconds))
+	assert.Nil(t, err)
+	assert.True(t, time.UnixMilli(1481750076).Equal(seconds.Value.(time.Time)))
+}
+
+func TestNeptuneDateCutoff(t *testing.T) {
+	cutoff := time.Date(1973, time.March, 3, 9, 46, 40, 0, time.UTC)
+
+	assert.True(t, cutoff.Equal(neptuneDate(1e11)), "1e11 is the first value read as milliseconds")
+	assert.True(t, time.Unix(1e11-1, 0).Equal(neptuneDate(1e11-1)), "anything below is read as seconds")
+
+	// a millisecond date before the cutoff is misread as seconds
+	day := int64(24 * time.Hour / time.Millisecond)
+	assert.True(t, time.Unix(day, 0).Equal(neptuneDate(day)))
+	assert.False(t, time.UnixMilli(day).Equal(neptuneDate(day)))
+}
+
+func TestNeptuneRegistered(t *testing.T) {
+	assert.Equal(t, GraphSONv3Parser{Neptune: true}, graphson.NewParser("neptune"))
+}
@@ -0,0 +1,110 @@
+package graphson3
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+const pathTypeName = "g:Path"
+
+// parsePath expects the input to be valid JSON and to be a single Path record, a g:List of label g:Sets alongside a g:List
+// of the objects traversed. See http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_path_3.
+func (g GraphSONv3Parser) parsePath(in []byte) (path graphson.PathRecord, err error) {
+	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || typeName != pathTypeName {
+		return path, graphson.ParsingError{Message: "provided input not a g:Path type", Operation: "parsePath", Field: "@type"}
+	}
+
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"@value", "labels"},
+		{"@value", "objects"},
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		currentError := graphson.ParsingError{Operation: "parsePath", Field: strings.Join(paths[idx], " ")}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		switch idx {
+		case 0: // @value -> labels
+			steps, e := g.parseSet(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			path.Labels = make([][]string, 0, len(steps))
+			for _, step := range steps {
+				if step.Type != graphson.Set && step.Type != graphson.List {
+					currentError.Message = "path labels must be a g:Set"
+					break
+				}
+
+				labels := []string{}
+				for _, label := range step.Value.([]graphson.ValuePair) {
+					labels = append(labels, label.AsString())
+				}
+
+				path.Labels = append(path.Labels, labels)
+			}
+
+		case 1: // @value -> objects
+			objects, e := g.parseSet(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			path.Objects = objects
+		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+
+	}, paths...)
+
+	if len(path.Labels) != len(path.Objects) {
+		parsingErrors = append(parsingErrors, graphson.ParsingError{Message: "path labels and objects differ in length", Operation: "parsePath", Field: "@value"})
+	}
+
+	return path, parsingErrors.Combine()
+}
+
+func (s GraphSONv3Serializer) writePath(buf *bytes.Buffer, in graphson.PathRecord) error {
+	return writeTyped(buf, pathTypeName, func() error {
+		labels := make([]graphson.ValuePair, 0, len(in.Labels))
+		for _, step := range in.Labels {
+			set := make([]graphson.ValuePair, 0, len(step))
+			for _, label := range step {
+				set = append(set, graphson.ValuePair{Type: graphson.String, Value: label})
+			}
+
+			labels = append(labels, graphson.ValuePair{Type: graphson.Set, Value: set})
+		}
+
+		buf.WriteString(`{"labels":`)
+		if err := s.writeValuePair(buf, graphson.ValuePair{Type: graphson.List, Value: labels}); err != nil {
+			return err
+		}
+
+		buf.WriteString(`,"objects":`)
+		if err := s.writeValuePair(buf, graphson.ValuePair{Type: graphson.List, Value: in.Objects}); err != nil {
+			return err
+		}
+
+		buf.WriteByte('}')
+
+		return nil
+	})
+}
@@ -0,0 +1,45 @@
+package graphson3
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePath(t *testing.T) {
+	g := GraphSONv3Parser{}
+	vp, err := g.Parse([]byte(path30))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Path, vp.Type)
+
+	path := vp.AsPath()
+	assert.Len(t, path.Objects, 3)
+	assert.Equal(t, [][]string{{"a"}, {}, {"c", "d"}}, path.Labels)
+
+	assert.Equal(t, "person", path.Objects[0].AsVertex().Label)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 10}, path.Objects[1].AsVertex().ID)
+	assert.Equal(t, "gremlin", path.Objects[2].AsString())
+
+	object, ok := path.Get("d")
+	assert.True(t, ok)
+	assert.Equal(t, "gremlin", object.AsString())
+
+	_, ok = path.Get("b")
+	assert.False(t, ok)
+}
+
+func TestSerializePath(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	original, err := g.Parse([]byte(path30))
+	assert.Nil(t, err)
+
+	out, err := s.Serialize(original)
+	assert.Nil(t, err)
+
+	reparsed, err := g.Parse(out)
+	assert.Nil(t, err)
+	assert.Equal(t, original, reparsed)
+}
@@ -0,0 +1,165 @@
+package graphson3
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dnoberon/graphson"
+)
+
+// SerializeRequest writes a Gremlin Server request message. The args, bindings and aliases are written as g:Map and each
+// binding is written according to its ValueType, so a binding of graphson.Int64 arrives at the server as a Long.
+func (s GraphSONv3Serializer) SerializeRequest(in graphson.RequestMessage) ([]byte, error) {
+	if in.RequestID == "" {
+		return nil, graphson.ParsingError{Message: "request message missing request id", Operation: "serializeRequest", Field: "requestId"}
+	}
+
+	if in.Op == "" {
+		return nil, graphson.ParsingError{Message: "request message missing op", Operation: "serializeRequest", Field: "op"}
+	}
+
+	buf := &bytes.Buffer{}
+
+	buf.WriteString(`{"requestId":`)
+	if err := s.writeValuePair(buf, graphson.ValuePair{Type: graphson.UUID, Value: in.RequestID}); err != nil {
+		return nil, err
+	}
+
+	buf.WriteString(`,"op":`)
+	if err := writeString(buf, in.Op); err != nil {
+		return nil, err
+	}
+
+	buf.WriteString(`,"processor":`)
+	if err := writeString(buf, in.Processor); err != nil {
+		return nil, err
+	}
+
+	buf.WriteString(`,"args":`)
+	if err := writeTyped(buf, "g:Map", func() error { return s.writeArgs(buf, in.Args) }); err != nil {
+		return nil, err
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func (s GraphSONv3Serializer) writeArgs(buf *bytes.Buffer, in graphson.RequestArgs) error {
+	args := map[string]graphson.ValuePair{}
+
+	for key, value := range in.Other {
+		// the well known arguments have fields of their own, writing them twice would give the map duplicate keys
+		switch key {
+		case "gremlin", "language", "evaluationTimeout", "bindings", "aliases":
+			return graphson.ParsingError{Message: fmt.Sprintf("%s is a RequestArgs field, not one of Other", key), Operation: "serializeRequest", Field: "args"}
+		}
+
+		args[key] = value
+	}
+
+	if in.Gremlin != "" {
+		args["gremlin"] = graphson.ValuePair{Type: graphson.String, Value: in.Gremlin}
+	}
+
+	if in.Language != "" {
+		args["language"] = graphson.ValuePair{Type: graphson.String, Value: in.Language}
+	}
+
+	if in.EvaluationTimeout > 0 {
+		args["evaluationTimeout"] = graphson.ValuePair{Type: graphson.Int64, Value: int64(in.EvaluationTimeout / time.Millisecond)}
+	}
+
+	buf.WriteByte('[')
+
+	first := true
+	writeKey := func(key string) error {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		if err := writeString(buf, key); err != nil {
+			return err
+		}
+
+		buf.WriteByte(',')
+
+		return nil
+	}
+
+	for _, key := range sortedKeys(args) {
+		if err := writeKey(key); err != nil {
+			return err
+		}
+
+		if err := s.writeValuePair(buf, args[key]); err != nil {
+			return err
+		}
+	}
+
+	if len(in.Bindings) > 0 {
+		if err := writeKey("bindings"); err != nil {
+			return err
+		}
+
+		err := writeTyped(buf, "g:Map", func() error {
+			return s.writeStringMap(buf, sortedKeys(in.Bindings), func(key string) error { return s.writeValuePair(buf, in.Bindings[key]) })
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(in.Aliases) > 0 {
+		if err := writeKey("aliases"); err != nil {
+			return err
+		}
+
+		aliases := make([]string, 0, len(in.Aliases))
+		for key := range in.Aliases {
+			aliases = append(aliases, key)
+		}
+		sort.Strings(aliases)
+
+		err := writeTyped(buf, "g:Map", func() error {
+			return s.writeStringMap(buf, aliases, func(key string) error { return writeString(buf, in.Aliases[key]) })
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	buf.WriteByte(']')
+
+	return nil
+}
+
+// writeStringMap writes the flat key/value array of a g:Map whose keys are all strings
+func (s GraphSONv3Serializer) writeStringMap(buf *bytes.Buffer, keys []string, value func(key string) error) error {
+	buf.WriteByte('[')
+
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		if err := writeString(buf, key); err != nil {
+			return err
+		}
+
+		buf.WriteByte(',')
+
+		if err := value(key); err != nil {
+			return err
+		}
+	}
+
+	buf.WriteByte(']')
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package graphson3
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeRequest(t *testing.T) {
+	s := GraphSONv3Serializer{}
+
+	request := graphson.RequestMessage{
+		RequestID: "cb682578-9d92-4499-9ebc-5c6aa73c5397",
+		Op:        graphson.OpEval,
+		Processor: graphson.ProcessorStandard,
+		Args: graphson.RequestArgs{
+			Gremlin: "g.V(x).has('age', gt(age))",
+			Bindings: map[string]graphson.ValuePair{
+				"x":   {Type: graphson.Int32, Value: 1},
+				"age": {Type: graphson.Int64, Value: int64(29)},
+			},
+			Language:          "gremlin-groovy",
+			Aliases:           map[string]string{"g": "social"},
+			EvaluationTimeout: 30 * time.Second,
+		},
+	}
+
+	out, err := s.SerializeRequest(request)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"requestId":{"@type":"g:UUID","@value":"cb682578-9d92-4499-9ebc-5c6aa73c5397"},"op":"eval","processor":"",`+
+		`"args":{"@type":"g:Map","@value":["evaluationTimeout",{"@type":"g:Int64","@value":30000},"gremlin","g.V(x).has('age', gt(age))","language","gremlin-groovy",`+
+		`"bindings",{"@type":"g:Map","@value":["age",{"@type":"g:Int64","@value":29},"x",{"@type":"g:Int32","@value":1}]},`+
+		`"aliases",{"@type":"g:Map","@value":["g","social"]}]}}`, string(out))
+}
+
+func TestSerializeRequestBindingList(t *testing.T) {
+	s := GraphSONv3Serializer{}
+
+	request := graphson.NewEvalRequest("g.V(ids)", map[string]graphson.ValuePair{
+		"ids": {Type: graphson.List, Value: []graphson.ValuePair{{Type: graphson.Int32, Value: 1}, {Type: graphson.Int32, Value: 2}}},
+	})
+
+	assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), request.RequestID)
+
+	out, err := s.SerializeRequest(request)
+	assert.Nil(t, err)
+	assert.Contains(t, string(out), `"bindings",{"@type":"g:Map","@value":["ids",{"@type":"g:List","@value":[{"@type":"g:Int32","@value":1},{"@type":"g:Int32","@value":2}]}]}`)
+}
+
+func TestSerializeRequestInvalid(t *testing.T) {
+	s := GraphSONv3Serializer{}
+
+	_, err := s.SerializeRequest(graphson.RequestMessage{Op: graphson.OpEval})
+	assert.NotNil(t, err)
+
+	_, err = s.SerializeRequest(graphson.RequestMessage{RequestID: graphson.NewRequestID(), Op: graphson.OpEval, Args: graphson.RequestArgs{
+		Bindings: map[string]graphson.ValuePair{"x": {Type: graphson.Int32, Value: "1"}},
+	}})
+	assert.NotNil(t, err)
+
+	// a well known argument in Other would be written next to its own field
+	for _, key := range []string{"gremlin", "language", "evaluationTimeout", "bindings", "aliases"} {
+		_, err = s.SerializeRequest(graphson.RequestMessage{RequestID: graphson.NewRequestID(), Op: graphson.OpEval, Args: graphson.RequestArgs{
+			Gremlin: "g.V()",
+			Other:   map[string]graphson.ValuePair{key: {Type: graphson.String, Value: "x"}},
+		}})
+		assert.NotNil(t, err, key)
+	}
+}
@@ -0,0 +1,11 @@
+package graphson3
+
+import (
+	"github.com/dnoberon/graphson"
+)
+
+// ParseResponse expects the input to be a complete Gremlin Server response message. Result data, meta and the status
+// attributes are run through Parse, see graphson.ParseResponseMessage.
+func (g GraphSONv3Parser) ParseResponse(in []byte) (graphson.ResponseMessage, error) {
+	return graphson.ParseResponseMessage(in, g.Parse)
+}
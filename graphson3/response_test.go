@@ -0,0 +1,119 @@
+package graphson3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+const response30 = `{
+  "requestId" : "41d2e28a-20a4-4ab0-b379-d810dede3786",
+  "status" : {
+    "message" : "",
+    "code" : 200,
+    "attributes" : {
+      "@type" : "g:Map",
+      "@value" : [ ]
+    }
+  },
+  "result" : {
+    "data" : {
+      "@type" : "g:List",
+      "@value" : [ {
+        "@type" : "g:Int32",
+        "@value" : 1
+      }, {
+        "@type" : "g:Int64",
+        "@value" : 2
+      } ]
+    },
+    "meta" : {
+      "@type" : "g:Map",
+      "@value" : [ ]
+    }
+  }
+}`
+
+const noContentResponse30 = `{
+  "requestId" : "41d2e28a-20a4-4ab0-b379-d810dede3786",
+  "status" : {
+    "message" : "",
+    "code" : 204,
+    "attributes" : {
+      "@type" : "g:Map",
+      "@value" : [ ]
+    }
+  },
+  "result" : {
+    "data" : null,
+    "meta" : {
+      "@type" : "g:Map",
+      "@value" : [ ]
+    }
+  }
+}`
+
+const errorResponse30 = `{
+  "requestId" : "41d2e28a-20a4-4ab0-b379-d810dede3786",
+  "status" : {
+    "message" : "No such property: x for class: Script1",
+    "code" : 597,
+    "attributes" : {
+      "@type" : "g:Map",
+      "@value" : [ ]
+    }
+  },
+  "result" : {
+    "data" : null,
+    "meta" : {
+      "@type" : "g:Map",
+      "@value" : [ ]
+    }
+  }
+}`
+
+func TestParseResponse(t *testing.T) {
+	g := GraphSONv3Parser{}
+	response, err := g.ParseResponse([]byte(response30))
+	assert.Nil(t, err)
+
+	assert.Equal(t, "41d2e28a-20a4-4ab0-b379-d810dede3786", response.RequestID)
+	assert.Equal(t, graphson.StatusSuccess, response.Status.Code)
+	assert.Equal(t, graphson.List, response.Data.Type)
+
+	data := response.Data.Value.([]graphson.ValuePair)
+	assert.Len(t, data, 2)
+	assert.Equal(t, 1, data[0].AsInt32())
+	assert.Equal(t, int64(2), data[1].AsInt64())
+}
+
+func TestParseResponseNoContent(t *testing.T) {
+	g := GraphSONv3Parser{}
+	response, err := g.ParseResponse([]byte(noContentResponse30))
+	assert.Nil(t, err)
+
+	assert.Equal(t, graphson.StatusNoContent, response.Status.Code)
+	assert.Nil(t, response.Data.Value)
+}
+
+func TestParseResponseError(t *testing.T) {
+	g := GraphSONv3Parser{}
+	response, err := g.ParseResponse([]byte(errorResponse30))
+
+	assert.True(t, errors.Is(err, graphson.ErrScriptEvaluationError))
+	assert.False(t, errors.Is(err, graphson.ErrServerError))
+
+	var responseErr graphson.ResponseError
+	assert.True(t, errors.As(err, &responseErr))
+	assert.Equal(t, "No such property: x for class: Script1", responseErr.Message)
+	assert.Equal(t, graphson.StatusScriptEvaluationError, response.Status.Code)
+}
+
+func TestParseResponseMalformed(t *testing.T) {
+	g := GraphSONv3Parser{}
+	_, err := g.ParseResponse([]byte(`{"requestId":"41d2e28a-20a4-4ab0-b379-d810dede3786"}`))
+
+	assert.IsType(t, graphson.ParsingError{}, err)
+}
@@ -0,0 +1,564 @@
+package graphson3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/dnoberon/graphson"
+)
+
+// GraphSONv3Serializer writes records and ValuePair types back out as GraphSON 3. It expects each ValuePair to hold the
+// same Go type GraphSONv3Parser would have produced for that ValueType.
+type GraphSONv3Serializer struct{}
+
+// Serialize writes a single ValuePair as a GraphSON 3 value, recursing in to collections and graph elements.
+func (s GraphSONv3Serializer) Serialize(in graphson.ValuePair) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := s.writeValuePair(buf, in); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SerializeVertex writes a VertexRecord as a g:Vertex.
+func (s GraphSONv3Serializer) SerializeVertex(in graphson.VertexRecord) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := s.writeVertex(buf, in); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SerializeVertexProperty writes a VertexPropertyRecord as a g:VertexProperty.
+func (s GraphSONv3Serializer) SerializeVertexProperty(in graphson.VertexPropertyRecord) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := s.writeVertexProperty(buf, in); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SerializeEdge writes an EdgeRecord as a g:Edge.
+func (s GraphSONv3Serializer) SerializeEdge(in graphson.EdgeRecord) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := s.writeEdge(buf, in); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SerializeProperty writes a Property as a g:Property.
+func (s GraphSONv3Serializer) SerializeProperty(in graphson.Property) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := s.writeProperty(buf, in); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s GraphSONv3Serializer) writeValuePair(buf *bytes.Buffer, in graphson.ValuePair) (err error) {
+	defer func() {
+		// a ValuePair whose Value doesn't match its Type is a programming error on the caller's part, report it as such
+		if r := recover(); r != nil {
+			err = graphson.ParsingError{Message: fmt.Sprintf("value %T does not match type %v", in.Value, in.Type), Operation: "serialize", Field: "@value"}
+		}
+	}()
+
+	switch in.Type {
+	case graphson.String:
+		return writeString(buf, in.Value.(string))
+	case graphson.Boolean:
+		buf.WriteString(strconv.FormatBool(in.Value.(bool)))
+	case graphson.Class:
+		return writeTyped(buf, "g:Class", func() error { return writeString(buf, in.Value.(string)) })
+	case graphson.UUID:
+		return writeTyped(buf, "g:UUID", func() error { return writeString(buf, in.Value.(string)) })
+	case graphson.Int32:
+		return writeTyped(buf, "g:Int32", func() error { buf.WriteString(strconv.Itoa(in.Value.(int))); return nil })
+	case graphson.Int64:
+		return writeTyped(buf, "g:Int64", func() error { buf.WriteString(strconv.FormatInt(in.Value.(int64), 10)); return nil })
+	case graphson.Double:
+		return writeTyped(buf, "g:Double", func() error { return writeFloat(buf, in.Value.(float64), 64) })
+	case graphson.Float:
+		return writeTyped(buf, "g:Float", func() error { return writeFloat(buf, float64(in.Value.(float32)), 32) })
+	case graphson.Date:
+		return writeTyped(buf, "g:Date", func() error { return writeMillis(buf, in.Value.(time.Time)) })
+	case graphson.Timestamp:
+		return writeTyped(buf, "g:Timestamp", func() error { return writeMillis(buf, in.Value.(time.Time)) })
+	case graphson.List:
+		return writeTyped(buf, "g:List", func() error { return s.writeArray(buf, in.Value.([]graphson.ValuePair)) })
+	case graphson.Set:
+		return writeTyped(buf, "g:Set", func() error { return s.writeArray(buf, in.Value.([]graphson.ValuePair)) })
+	case graphson.BulkSet:
+		return writeTyped(buf, "g:BulkSet", func() error { return s.writeBulkSet(buf, in.Value.(graphson.BulkSetRecord)) })
+	case graphson.Map:
+		return writeTyped(buf, "g:Map", func() error { return s.writeMap(buf, in.Value) })
+	case graphson.T:
+		return writeTyped(buf, "g:T", func() error { return writeString(buf, string(in.Value.(graphson.TToken))) })
+	case graphson.Direction:
+		return writeTyped(buf, "g:Direction", func() error { return writeString(buf, string(in.Value.(graphson.DirectionToken))) })
+	case graphson.Cardinality:
+		return writeTyped(buf, "g:Cardinality", func() error { return writeString(buf, string(in.Value.(graphson.CardinalityToken))) })
+	case graphson.Order:
+		return writeTyped(buf, "g:Order", func() error { return writeString(buf, string(in.Value.(graphson.OrderToken))) })
+	case graphson.Pop:
+		return writeTyped(buf, "g:Pop", func() error { return writeString(buf, string(in.Value.(graphson.PopToken))) })
+	case graphson.Scope:
+		return writeTyped(buf, "g:Scope", func() error { return writeString(buf, string(in.Value.(graphson.ScopeToken))) })
+	case graphson.Column:
+		return writeTyped(buf, "g:Column", func() error { return writeString(buf, string(in.Value.(graphson.ColumnToken))) })
+	case graphson.Barrier:
+		return writeTyped(buf, "g:Barrier", func() error { return writeString(buf, string(in.Value.(graphson.BarrierToken))) })
+	case graphson.Operator:
+		return writeTyped(buf, "g:Operator", func() error { return writeString(buf, string(in.Value.(graphson.OperatorToken))) })
+	case graphson.Pick:
+		return writeTyped(buf, "g:Pick", func() error { return writeString(buf, string(in.Value.(graphson.PickToken))) })
+	case graphson.Vertex:
+		return s.writeVertex(buf, in.Value.(graphson.VertexRecord))
+	case graphson.VertexProperty:
+		return s.writeVertexProperty(buf, in.Value.(graphson.VertexPropertyRecord))
+	case graphson.Edge:
+		return s.writeEdge(buf, in.Value.(graphson.EdgeRecord))
+	case graphson.EdgeProperty:
+		return s.writeProperty(buf, in.Value.(graphson.Property))
+	case graphson.Path:
+		return s.writePath(buf, in.Value.(graphson.PathRecord))
+	case graphson.Tree:
+		return s.writeTree(buf, in.Value.(graphson.TreeRecord).Roots)
+	case graphson.Traverser:
+		return s.writeTraverser(buf, in.Value.(graphson.TraverserRecord))
+	case graphson.TraversalMetrics:
+		return s.writeTraversalMetrics(buf, in.Value.(graphson.TraversalMetricsRecord))
+	case graphson.Metrics:
+		return s.writeMetrics(buf, in.Value.(graphson.MetricsRecord))
+	case graphson.TraversalExplanation:
+		return s.writeTraversalExplanation(buf, in.Value.(graphson.TraversalExplanationRecord))
+	case graphson.BigDecimal, graphson.BigInteger, graphson.Byte, graphson.ByteBuffer, graphson.Char, graphson.Duration,
+		graphson.Instant, graphson.LocalDate, graphson.LocalDateTime, graphson.LocalTime, graphson.MonthDay,
+		graphson.OffsetDateTime, graphson.OffsetTime, graphson.Period, graphson.Short, graphson.Year, graphson.YearMonth,
+		graphson.ZonedDateTime, graphson.ZoneOffset:
+		return s.writeExtended(buf, in)
+	default:
+		return s.writeCustom(buf, in)
+	}
+
+	return nil
+}
+
+// writeInterface serializes the loosely typed IDs found on graph elements, inferring a GraphSON type from the Go type
+func (s GraphSONv3Serializer) writeInterface(buf *bytes.Buffer, in interface{}) error {
+	switch v := in.(type) {
+	case graphson.ValuePair:
+		return s.writeValuePair(buf, v)
+	case string:
+		return writeString(buf, v)
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+		return nil
+	case int:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Int32, Value: v})
+	case int32:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Int32, Value: int(v)})
+	case int64:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Int64, Value: v})
+	case float32:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Float, Value: v})
+	case float64:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Double, Value: v})
+	case time.Time:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Timestamp, Value: v})
+	case int8:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Byte, Value: v})
+	case int16:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Short, Value: v})
+	case []byte:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.ByteBuffer, Value: v})
+	case *big.Int:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.BigInteger, Value: v})
+	case *big.Float:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.BigDecimal, Value: v})
+	case time.Duration:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Duration, Value: v})
+	case graphson.TToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.T, Value: v})
+	case graphson.DirectionToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Direction, Value: v})
+	case graphson.CardinalityToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Cardinality, Value: v})
+	case graphson.OrderToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Order, Value: v})
+	case graphson.PopToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Pop, Value: v})
+	case graphson.ScopeToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Scope, Value: v})
+	case graphson.ColumnToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Column, Value: v})
+	case graphson.BarrierToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Barrier, Value: v})
+	case graphson.OperatorToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Operator, Value: v})
+	case graphson.PickToken:
+		return s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Pick, Value: v})
+	}
+
+	return graphson.ParsingError{Message: fmt.Sprintf("unable to serialize Go type %T", in), Operation: "serialize", Field: "@value"}
+}
+
+func (s GraphSONv3Serializer) writeArray(buf *bytes.Buffer, in []graphson.ValuePair) error {
+	buf.WriteByte('[')
+
+	for i, vp := range in {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		if err := s.writeValuePair(buf, vp); err != nil {
+			return err
+		}
+	}
+
+	buf.WriteByte(']')
+
+	return nil
+}
+
+func (s GraphSONv3Serializer) writeBulkSet(buf *bytes.Buffer, in graphson.BulkSetRecord) error {
+	flat := make([]graphson.ValuePair, 0, len(in.Entries)*2)
+
+	for _, entry := range in.Entries {
+		flat = append(flat, entry.Value, graphson.ValuePair{Type: graphson.Int64, Value: entry.Bulk})
+	}
+
+	return s.writeArray(buf, flat)
+}
+
+func (s GraphSONv3Serializer) writeTraverser(buf *bytes.Buffer, in graphson.TraverserRecord) error {
+	return writeTyped(buf, traverserTypeName, func() error {
+		buf.WriteString(`{"bulk":`)
+		if err := s.writeValuePair(buf, graphson.ValuePair{Type: graphson.Int64, Value: in.Bulk}); err != nil {
+			return err
+		}
+
+		buf.WriteString(`,"value":`)
+		if err := s.writeValuePair(buf, in.Value); err != nil {
+			return err
+		}
+
+		buf.WriteByte('}')
+
+		return nil
+	})
+}
+
+// writeMap accepts either the MapRecord produced by parseMap or a Go map
+func (s GraphSONv3Serializer) writeMap(buf *bytes.Buffer, in interface{}) error {
+	switch m := in.(type) {
+	case graphson.MapRecord:
+		buf.WriteByte('[')
+
+		for i, entry := range m.Entries {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := s.writeValuePair(buf, entry.Key); err != nil {
+				return err
+			}
+
+			buf.WriteByte(',')
+
+			if err := s.writeValuePair(buf, entry.Value); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte(']')
+
+		return nil
+
+	case map[interface{}]interface{}:
+		// Go maps are unordered, sort the serialized keys so output is at least deterministic
+		entries := make([][2][]byte, 0, len(m))
+		for key, value := range m {
+			k, v := &bytes.Buffer{}, &bytes.Buffer{}
+			if err := s.writeInterface(k, key); err != nil {
+				return err
+			}
+
+			if err := s.writeInterface(v, value); err != nil {
+				return err
+			}
+
+			entries = append(entries, [2][]byte{k.Bytes(), v.Bytes()})
+		}
+
+		sort.Slice(entries, func(i, j int) bool { return bytes.Compare(entries[i][0], entries[j][0]) < 0 })
+
+		buf.WriteByte('[')
+		for i, entry := range entries {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			buf.Write(entry[0])
+			buf.WriteByte(',')
+			buf.Write(entry[1])
+		}
+		buf.WriteByte(']')
+
+		return nil
+	}
+
+	return graphson.ParsingError{Message: fmt.Sprintf("unable to serialize map of Go type %T", in), Operation: "serializeMap", Field: "@value"}
+}
+
+// writeID writes an element ID, or null for an element that hasn't been given one yet such as a marshalled struct.
+func (s GraphSONv3Serializer) writeID(buf *bytes.Buffer, id graphson.ValuePair) error {
+	if id.Value == nil {
+		buf.WriteString("null")
+		return nil
+	}
+
+	return s.writeValuePair(buf, id)
+}
+
+func (s GraphSONv3Serializer) writeVertex(buf *bytes.Buffer, in graphson.VertexRecord) error {
+	return writeTyped(buf, vertexTypeName, func() error {
+		buf.WriteString(`{"id":`)
+		if err := s.writeID(buf, in.ID); err != nil {
+			return err
+		}
+
+		buf.WriteString(`,"label":`)
+		if err := writeString(buf, in.Label); err != nil {
+			return err
+		}
+
+		if len(in.Properties) > 0 {
+			buf.WriteString(`,"properties":{`)
+
+			for i, name := range sortedKeys(in.Properties) {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+
+				if err := writeString(buf, name); err != nil {
+					return err
+				}
+
+				buf.WriteString(":[")
+				for j, property := range in.Properties[name] {
+					if j > 0 {
+						buf.WriteByte(',')
+					}
+
+					if err := s.writeVertexProperty(buf, property); err != nil {
+						return err
+					}
+				}
+				buf.WriteByte(']')
+			}
+
+			buf.WriteByte('}')
+		}
+
+		buf.WriteByte('}')
+
+		return nil
+	})
+}
+
+func (s GraphSONv3Serializer) writeVertexProperty(buf *bytes.Buffer, in graphson.VertexPropertyRecord) error {
+	return writeTyped(buf, vertexPropertyTypeName, func() error {
+		buf.WriteString(`{"id":`)
+		if err := s.writeID(buf, in.ID); err != nil {
+			return err
+		}
+
+		buf.WriteString(`,"value":`)
+		if err := s.writeValuePair(buf, in.Value); err != nil {
+			return err
+		}
+
+		buf.WriteString(`,"label":`)
+		if err := writeString(buf, in.Label); err != nil {
+			return err
+		}
+
+		if len(in.Properties) > 0 {
+			buf.WriteString(`,"properties":{`)
+
+			for i, name := range sortedKeys(in.Properties) {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+
+				if err := writeString(buf, name); err != nil {
+					return err
+				}
+
+				buf.WriteByte(':')
+				if err := s.writeValuePair(buf, in.Properties[name]); err != nil {
+					return err
+				}
+			}
+
+			buf.WriteByte('}')
+		}
+
+		buf.WriteByte('}')
+
+		return nil
+	})
+}
+
+func (s GraphSONv3Serializer) writeEdge(buf *bytes.Buffer, in graphson.EdgeRecord) error {
+	return writeTyped(buf, edgeTypename, func() error {
+		buf.WriteString(`{"id":`)
+		if err := s.writeID(buf, in.ID); err != nil {
+			return err
+		}
+
+		for _, field := range [][2]string{{"label", in.Label}, {"inVLabel", in.InVLabel}, {"outVLabel", in.OutVLabel}} {
+			buf.WriteString(`,"` + field[0] + `":`)
+			if err := writeString(buf, field[1]); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteString(`,"inV":`)
+		if err := s.writeID(buf, in.InV); err != nil {
+			return err
+		}
+
+		buf.WriteString(`,"outV":`)
+		if err := s.writeID(buf, in.OutV); err != nil {
+			return err
+		}
+
+		if len(in.Properties) > 0 {
+			buf.WriteString(`,"properties":{`)
+
+			for i, name := range sortedKeys(in.Properties) {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+
+				if err := writeString(buf, name); err != nil {
+					return err
+				}
+
+				buf.WriteByte(':')
+				if err := s.writeProperty(buf, in.Properties[name]); err != nil {
+					return err
+				}
+			}
+
+			buf.WriteByte('}')
+		}
+
+		buf.WriteByte('}')
+
+		return nil
+	})
+}
+
+func (s GraphSONv3Serializer) writeProperty(buf *bytes.Buffer, in graphson.Property) error {
+	return writeTyped(buf, propertyTypeName, func() error {
+		buf.WriteString(`{"key":`)
+		if err := writeString(buf, in.Key); err != nil {
+			return err
+		}
+
+		buf.WriteString(`,"value":`)
+		if err := s.writeValuePair(buf, in.Value); err != nil {
+			return err
+		}
+
+		buf.WriteByte('}')
+
+		return nil
+	})
+}
+
+// writeTyped wraps whatever value writes to the buffer in a GraphSON 3 @type/@value envelope
+func writeTyped(buf *bytes.Buffer, typeName string, value func() error) error {
+	buf.WriteString(`{"@type":"`)
+	buf.WriteString(typeName)
+	buf.WriteString(`","@value":`)
+
+	if err := value(); err != nil {
+		return err
+	}
+
+	buf.WriteByte('}')
+
+	return nil
+}
+
+func writeString(buf *bytes.Buffer, in string) error {
+	out, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	buf.Write(out)
+
+	return nil
+}
+
+func writeFloat(buf *bytes.Buffer, in float64, bitSize int) error {
+	// JSON has no representation for these, GraphSON writes them as strings
+	switch {
+	case math.IsNaN(in):
+		return writeString(buf, "NaN")
+	case math.IsInf(in, 1):
+		return writeString(buf, "Infinity")
+	case math.IsInf(in, -1):
+		return writeString(buf, "-Infinity")
+	}
+
+	buf.WriteString(strconv.FormatFloat(in, 'g', -1, bitSize))
+
+	return nil
+}
+
+func writeMillis(buf *bytes.Buffer, in time.Time) error {
+	buf.WriteString(strconv.FormatInt(in.UnixNano()/int64(time.Millisecond), 10))
+
+	return nil
+}
+
+func sortedKeys(in interface{}) []string {
+	var keys []string
+
+	switch m := in.(type) {
+	case map[string][]graphson.VertexPropertyRecord:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]graphson.ValuePair:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	case map[string]graphson.Property:
+		for key := range m {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,142 @@
+package graphson3
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializeScalars(t *testing.T) {
+	s := GraphSONv3Serializer{}
+
+	cases := []struct {
+		in       graphson.ValuePair
+		expected string
+	}{
+		{graphson.ValuePair{Type: graphson.String, Value: "marko"}, `"marko"`},
+		{graphson.ValuePair{Type: graphson.Boolean, Value: true}, `true`},
+		{graphson.ValuePair{Type: graphson.Int32, Value: 100}, `{"@type":"g:Int32","@value":100}`},
+		{graphson.ValuePair{Type: graphson.Int64, Value: int64(100)}, `{"@type":"g:Int64","@value":100}`},
+		{graphson.ValuePair{Type: graphson.UUID, Value: "41d2e28a-20a4-4ab0-b379-d810dede3786"}, `{"@type":"g:UUID","@value":"41d2e28a-20a4-4ab0-b379-d810dede3786"}`},
+		{graphson.ValuePair{Type: graphson.Timestamp, Value: time.Unix(1481750076, 295*int64(time.Millisecond))}, `{"@type":"g:Timestamp","@value":1481750076295}`},
+	}
+
+	for _, c := range cases {
+		out, err := s.Serialize(c.in)
+		assert.Nil(t, err)
+		assert.Equal(t, c.expected, string(out))
+	}
+}
+
+func TestSerializeMismatch(t *testing.T) {
+	s := GraphSONv3Serializer{}
+
+	_, err := s.Serialize(graphson.ValuePair{Type: graphson.Int64, Value: "100"})
+	assert.NotNil(t, err)
+
+	_, err = s.Serialize(graphson.ValuePair{Type: graphson.Unknown})
+	assert.NotNil(t, err)
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	for _, in := range []string{vertex30, vertexProperty30, edge30, property30, set30, bulkSet30, traversers30, map30, elementMap30, timestamp30, date30, class30, uuid30, integer30, long30, double30, float30} {
+		original, err := g.Parse([]byte(in))
+		assert.Nil(t, err)
+
+		out, err := s.Serialize(original)
+		assert.Nil(t, err)
+
+		reparsed, err := g.Parse(out)
+		assert.Nil(t, err)
+		assert.Equal(t, original, reparsed, in)
+	}
+}
+
+func TestSerializeEscapedStrings(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	original, err := g.Parse([]byte(`{"@type":"g:List","@value":["a\"b","c\\d","e\u00e9\n",{"@type":"g:Map","@value":["k\"ey","v\\al"]}]}`))
+	assert.Nil(t, err)
+
+	values := original.Value.([]graphson.ValuePair)
+	assert.Equal(t, `a"b`, values[0].AsString())
+	assert.Equal(t, `c\d`, values[1].AsString())
+	assert.Equal(t, "e\u00e9\n", values[2].AsString())
+
+	value, ok := values[3].AsMap().Get(`k"ey`)
+	assert.True(t, ok)
+	assert.Equal(t, `v\al`, value.AsString())
+
+	out, err := s.Serialize(original)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"@type":"g:List","@value":["a\"b","c\\d","eé\n",{"@type":"g:Map","@value":["k\"ey","v\\al"]}]}`, string(out))
+
+	reparsed, err := g.Parse(out)
+	assert.Nil(t, err)
+	assert.Equal(t, original, reparsed)
+
+	// a string parsed on its own keeps working with or without its quotes
+	vp, err := g.Parse([]byte(`"say \"hi\""`))
+	assert.Nil(t, err)
+	assert.Equal(t, `say "hi"`, vp.AsString())
+}
+
+func TestSerializeNonFiniteFloats(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	for _, in := range []graphson.ValuePair{
+		{Type: graphson.Double, Value: math.NaN()},
+		{Type: graphson.Double, Value: math.Inf(1)},
+		{Type: graphson.Double, Value: math.Inf(-1)},
+		{Type: graphson.Float, Value: float32(math.NaN())},
+		{Type: graphson.Float, Value: float32(math.Inf(1))},
+		{Type: graphson.Float, Value: float32(math.Inf(-1))},
+	} {
+		out, err := s.Serialize(in)
+		assert.Nil(t, err)
+
+		reparsed, err := g.Parse(out)
+		assert.Nil(t, err, string(out))
+		assert.Equal(t, in.Type, reparsed.Type)
+
+		f, err := reparsed.Float64()
+		assert.Nil(t, err)
+
+		expected, _ := in.Float64()
+		if math.IsNaN(expected) {
+			assert.True(t, math.IsNaN(f), string(out))
+		} else {
+			assert.Equal(t, expected, f, string(out))
+		}
+	}
+
+	_, err := g.Parse([]byte(`{"@type":"g:Double","@value":"one"}`))
+	assert.NotNil(t, err)
+}
+
+func TestSerializeVertex(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	vertex, err := g.ParseVertex([]byte(vertex30))
+	assert.Nil(t, err)
+
+	out, err := s.SerializeVertex(vertex)
+	assert.Nil(t, err)
+
+	reparsed, err := g.ParseVertex(out)
+	assert.Nil(t, err)
+	assert.Equal(t, vertex, reparsed)
+}
+
+func TestSerializeRegistered(t *testing.T) {
+	assert.IsType(t, GraphSONv3Serializer{}, graphson.NewSerializer("v3"))
+}
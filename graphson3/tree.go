@@ -0,0 +1,99 @@
+package graphson3
+
+import (
+	"bytes"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+const treeTypeName = "g:Tree"
+
+// parseTree expects the input to be valid JSON and to be a single Tree record, a list of key/value entries whose values
+// are themselves g:Tree. See http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_tree_3.
+func (g GraphSONv3Parser) parseTree(in []byte) (graphson.TreeRecord, error) {
+	roots, err := g.parseTreeNodes(in)
+
+	return graphson.TreeRecord{Roots: roots}, err
+}
+
+func (g GraphSONv3Parser) parseTreeNodes(in []byte) ([]graphson.TreeNode, error) {
+	nodes := []graphson.TreeNode{}
+
+	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || typeName != treeTypeName {
+		return nil, graphson.ParsingError{Message: "provided input not a g:Tree type", Operation: "parseTree", Field: "@type"}
+	}
+
+	value, dt, _, err := jsonparser.Get(in, "@value")
+	if err != nil || dt != jsonparser.Array {
+		return nil, graphson.ParsingError{Message: "provided input not a valid g:Tree type, bad array", Operation: "parseTree", Field: "@value"}
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+	_, err = jsonparser.ArrayEach(value, func(entry []byte, dataType jsonparser.ValueType, offset int, err error) {
+		if err != nil {
+			parsingErrors = append(parsingErrors, graphson.ParsingError{Message: err.Error(), Operation: "parseTree", Field: "@value"})
+			return
+		}
+
+		node := graphson.TreeNode{}
+
+		key, _, _, err := jsonparser.Get(entry, "key")
+		if err != nil {
+			parsingErrors = append(parsingErrors, graphson.ParsingError{Message: err.Error(), Operation: "parseTree", Field: "key"})
+			return
+		}
+
+		node.Key, err = g.Parse(key)
+		if err != nil {
+			parsingErrors = append(parsingErrors, graphson.ParsingError{Message: err.Error(), Operation: "parseTree", Field: "key"})
+			return
+		}
+
+		// leaves are usually written with an empty g:Tree as their value, but tolerate it being left out entirely
+		if children, _, _, err := jsonparser.Get(entry, "value"); err == nil {
+			node.Children, err = g.parseTreeNodes(children)
+			if err != nil {
+				parsingErrors = append(parsingErrors, graphson.ParsingError{Message: err.Error(), Operation: "parseTree", Field: "value"})
+				return
+			}
+		}
+
+		nodes = append(nodes, node)
+	})
+
+	if err != nil {
+		return nodes, graphson.ParsingError{Message: err.Error(), Operation: "parseTree", Field: "@value"}
+	}
+
+	return nodes, parsingErrors.Combine()
+}
+
+func (s GraphSONv3Serializer) writeTree(buf *bytes.Buffer, in []graphson.TreeNode) error {
+	return writeTyped(buf, treeTypeName, func() error {
+		buf.WriteByte('[')
+
+		for i, node := range in {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			buf.WriteString(`{"key":`)
+			if err := s.writeValuePair(buf, node.Key); err != nil {
+				return err
+			}
+
+			buf.WriteString(`,"value":`)
+			if err := s.writeTree(buf, node.Children); err != nil {
+				return err
+			}
+
+			buf.WriteByte('}')
+		}
+
+		buf.WriteByte(']')
+
+		return nil
+	})
+}
@@ -0,0 +1,48 @@
+package graphson3
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTree(t *testing.T) {
+	g := GraphSONv3Parser{}
+	vp, err := g.Parse([]byte(tree30))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Tree, vp.Type)
+
+	tree := vp.AsTree()
+	assert.Len(t, tree.Roots, 1)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 1}, tree.Roots[0].Key.AsVertex().ID)
+	assert.Len(t, tree.Roots[0].Children, 2)
+	assert.Equal(t, 3, tree.Depth())
+
+	leaves := tree.Leaves()
+	assert.Len(t, leaves, 2)
+	assert.Equal(t, "gremlin", leaves[0].AsString())
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 11}, leaves[1].AsVertex().ID)
+
+	var depths []int
+	tree.Walk(func(depth int, node graphson.TreeNode) bool {
+		depths = append(depths, depth)
+		return node.Key.Type != graphson.Vertex || !node.Key.AsVertex().ID.Equal(graphson.ValuePair{Type: graphson.Int32, Value: 10})
+	})
+	assert.Equal(t, []int{0, 1, 1}, depths)
+}
+
+func TestSerializeTree(t *testing.T) {
+	g := GraphSONv3Parser{}
+	s := GraphSONv3Serializer{}
+
+	original, err := g.Parse([]byte(tree30))
+	assert.Nil(t, err)
+
+	out, err := s.Serialize(original)
+	assert.Nil(t, err)
+
+	reparsed, err := g.Parse(out)
+	assert.Nil(t, err)
+	assert.Equal(t, original, reparsed)
+}
@@ -0,0 +1,62 @@
+package graphson3
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLocation struct {
+	Name      string `graphson:"value"`
+	StartTime int    `graphson:"startTime"`
+	EndTime   *int   `graphson:"endTime"`
+}
+
+type testPerson struct {
+	ID        int64          `graphson:"id"`
+	Label     string         `graphson:"label"`
+	Name      string         `graphson:"name"`
+	Locations []testLocation `graphson:"location"`
+	Places    []string       `graphson:"location"`
+}
+
+// the field mapping itself is tested in the root package, these check that parsed documents unmarshal as expected
+
+func TestUnmarshalVertex(t *testing.T) {
+	vp, err := GraphSONv3Parser{}.Parse([]byte(vertex30))
+	assert.Nil(t, err)
+
+	var person testPerson
+	assert.Nil(t, graphson.Unmarshal(vp, &person))
+
+	assert.Equal(t, int64(1), person.ID)
+	assert.Equal(t, "person", person.Label)
+	assert.Equal(t, "marko", person.Name)
+	assert.Equal(t, []string{"san diego", "santa cruz", "brussels", "santa fe"}, person.Places)
+
+	assert.Len(t, person.Locations, 4)
+	assert.Equal(t, 1997, person.Locations[0].StartTime)
+	assert.Equal(t, 2001, *person.Locations[0].EndTime)
+	assert.Nil(t, person.Locations[3].EndTime)
+}
+
+func TestUnmarshalEdge(t *testing.T) {
+	vp, err := GraphSONv3Parser{}.Parse([]byte(edge30))
+	assert.Nil(t, err)
+
+	var develops struct {
+		ID    int32  `graphson:"id"`
+		Label string `graphson:"label"`
+		In    int32  `graphson:"inV"`
+		Out   int32  `graphson:"outV"`
+		Since int32  `graphson:"since"`
+	}
+	assert.Nil(t, graphson.Unmarshal(vp, &develops))
+
+	assert.Equal(t, int32(13), develops.ID)
+	assert.Equal(t, "develops", develops.Label)
+	assert.Equal(t, int32(10), develops.In)
+	assert.Equal(t, int32(1), develops.Out)
+	assert.Equal(t, int32(2009), develops.Since)
+}
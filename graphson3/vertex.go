@@ -8,22 +8,26 @@ import (
 	"github.com/buger/jsonparser"
 )
 
-const vertexTypeName = "g:VertexRecord"
-const vertexPropertyTypeName = "g:VertexPropertyRecord"
+const vertexTypeName = "g:Vertex"
+const vertexPropertyTypeName = "g:VertexProperty"
+
+// earlier releases of this package only accepted these misnamed types, they are still read but never written
+const legacyVertexTypeName = "g:VertexRecord"
+const legacyVertexPropertyTypeName = "g:VertexPropertyRecord"
 
 // ParseVertex expects the input to be valid JSON and to be a single VertexRecord record. See either the testing file for sample
 // vertex json records or http://tinkerpop.apache.org/docs/3.4.2/dev/io/#_vertex_3.
 func (g GraphSONv3Parser) ParseVertex(in []byte) (v graphson.VertexRecord, err error) {
 	v.Properties = map[string][]graphson.VertexPropertyRecord{}
 
-	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || typeName != vertexTypeName {
+	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || (typeName != vertexTypeName && typeName != legacyVertexTypeName) {
 		return v, graphson.ParsingError{err, "@type", "parseVertex"}
 	}
 
 	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
 	var paths = [][]string{
 		{"@value", "label"},
-		{"@value", "id", "@value"},
+		{"@value", "id"},
 		{"@value", "properties"},
 	}
 
@@ -48,7 +52,7 @@ func (g GraphSONv3Parser) ParseVertex(in []byte) (v graphson.VertexRecord, err e
 			v.Label = label
 
 		case 1: // @value -> label -> @value
-			id, e := parsedToType(value, vt)
+			id, e := g.elementID(value, vt)
 			if e != nil {
 				currentError.Message = e.Error()
 				break
@@ -124,14 +128,14 @@ func (g GraphSONv3Parser) ParseVertexProperties(in []byte) ([]graphson.VertexPro
 func (g GraphSONv3Parser) ParseVertexProperty(in []byte) (property graphson.VertexPropertyRecord, err error) {
 	property.Properties = map[string]graphson.ValuePair{}
 
-	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || typeName != vertexPropertyTypeName {
+	if typeName, err := jsonparser.GetString(in, "@type"); err != nil || (typeName != vertexPropertyTypeName && typeName != legacyVertexPropertyTypeName) {
 		return property, graphson.ParsingError{err, "@type", "parseVertexProperty"}
 	}
 
 	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
 	var paths = [][]string{
 		{"@value", "label"},
-		{"@value", "id", "@value"},
+		{"@value", "id"},
 		{"@value", "value"},
 		{"@value", "properties"},
 	}
@@ -157,8 +161,8 @@ func (g GraphSONv3Parser) ParseVertexProperty(in []byte) (property graphson.Vert
 
 			property.Label = label
 
-		case 1: // @value -> id -> @value
-			id, e := parsedToType(value, vt)
+		case 1: // @value -> id
+			id, e := g.elementID(value, vt)
 			if e != nil {
 				currentError.Message = e.Error()
 				break
@@ -167,7 +171,7 @@ func (g GraphSONv3Parser) ParseVertexProperty(in []byte) (property graphson.Vert
 			property.ID = id
 
 		case 2: // @value -> value
-			pValue, e := jsonparser.ParseString(value)
+			pValue, e := g.Parse(value)
 			if e != nil {
 				currentError.Message = e.Error()
 				break
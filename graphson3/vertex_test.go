@@ -3,6 +3,7 @@ package graphson3
 import (
 	"testing"
 
+	"github.com/dnoberon/graphson"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,24 +11,44 @@ func TestParseVertex(t *testing.T) {
 	g := GraphSONv3Parser{}
 	vertex, err := g.ParseVertex([]byte(vertex30))
 	assert.Nil(t, err)
-	assert.Equal(t, int64(1), vertex.ID)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int32, Value: 1}, vertex.ID)
 	assert.Equal(t, "person", vertex.Label)
 
 	assert.NotEmpty(t, vertex.Properties["name"])
 	assert.NotEmpty(t, vertex.Properties["location"])
-	assert.Equal(t, "marko", vertex.Properties["name"][0].Value)
-	assert.Equal(t, "san diego", vertex.Properties["location"][0].Value)
+	assert.Equal(t, "marko", vertex.Properties["name"][0].Value.AsString())
+	assert.Equal(t, "san diego", vertex.Properties["location"][0].Value.AsString())
 }
 
 func TestParseVertexProperty(t *testing.T) {
 	g := GraphSONv3Parser{}
 	property, err := g.ParseVertexProperty([]byte(vertexProperty30))
 	assert.Nil(t, err)
-	assert.Equal(t, int64(6), property.ID)
-	assert.Equal(t, "san diego", property.Value)
+	assert.Equal(t, graphson.ValuePair{Type: graphson.Int64, Value: int64(6)}, property.ID)
+	assert.Equal(t, "san diego", property.Value.AsString())
 	assert.Equal(t, "location", property.Label)
 
 	p, ok := property.Properties["startTime"]
 	assert.True(t, ok)
 	assert.Equal(t, int(1997), p.Value)
 }
+
+func TestParseVertexPropertyTypedValue(t *testing.T) {
+	g := GraphSONv3Parser{}
+	property, err := g.ParseVertexProperty([]byte(`{
+  "@type" : "g:VertexProperty",
+  "@value" : {
+    "id" : { "@type" : "g:Int64", "@value" : 2 },
+    "value" : { "@type" : "g:Int32", "@value" : 29 },
+    "label" : "age"
+  }
+}`))
+	assert.Nil(t, err)
+	assert.Equal(t, graphson.Int32, property.Value.Type)
+	assert.Equal(t, 29, property.Value.AsInt32())
+	assert.Equal(t, "29", property.ValueString())
+
+	property, err = g.ParseVertexProperty([]byte(`{"@type":"g:VertexProperty","@value":{"id":1,"value":"say \"hi\"","label":"greeting"}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, `say "hi"`, property.ValueString())
+}
@@ -0,0 +1,40 @@
+package graphson
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkSetRecord(t *testing.T) {
+	marko := ValuePair{Type: String, Value: "marko"}
+	josh := ValuePair{Type: String, Value: "josh"}
+
+	b := BulkSetRecord{Entries: []BulkEntry{{Value: marko, Bulk: 1}, {Value: josh, Bulk: -5}, {Value: josh, Bulk: 2}}}
+	assert.Equal(t, int64(3), b.Len())
+	_, err := b.Expand()
+	assert.NotNil(t, err, "a negative bulk is rejected")
+
+	b = BulkSetRecord{Entries: []BulkEntry{{Value: marko, Bulk: 1}, {Value: josh, Bulk: 2}}}
+	values, err := b.Expand()
+	assert.Nil(t, err)
+	assert.Equal(t, []ValuePair{marko, josh, josh}, values)
+
+	b = BulkSetRecord{Entries: []BulkEntry{{Value: marko, Bulk: math.MaxInt64}, {Value: josh, Bulk: math.MaxInt64}}}
+	assert.Equal(t, int64(math.MaxInt64), b.Len())
+
+	// bulks are counts, expanding a huge one is refused rather than attempted
+	b = BulkSetRecord{Entries: []BulkEntry{{Value: marko, Bulk: 1 << 62}}}
+	_, err = b.Expand()
+	assert.NotNil(t, err)
+
+	vp := ValuePair{Type: BulkSet, Value: b}
+	assert.Nil(t, vp.AsSet())
+
+	_, err = vp.Set()
+	assert.NotNil(t, err)
+
+	var names []string
+	assert.NotNil(t, Unmarshal(vp, &names))
+}
@@ -0,0 +1,32 @@
+// Package graphsoncosmos reads the results of the Azure Cosmos DB Gremlin API. Cosmos DB writes untyped, GraphSON 1.0
+// shaped JSON with string IDs and vertex properties as arrays of {id, value} objects, so the parser builds on the
+// GraphSON 1.0 parser and returns the same VertexRecord and EdgeRecord structures the other versions do. Importing the
+// package registers it under the name "cosmos".
+package graphsoncosmos
+
+import (
+	"github.com/dnoberon/graphson"
+	"github.com/dnoberon/graphson/graphson1"
+)
+
+// GraphSONCosmosParser handles Cosmos DB Gremlin API responses and the values within them.
+type GraphSONCosmosParser struct {
+	graphson1.GraphSONv1Parser
+}
+
+// RequestCharge returns the request units Cosmos DB charged for a request, as reported in the x-ms-request-charge status
+// attribute of its response.
+func RequestCharge(response graphson.ResponseMessage) float64 {
+	charge, _ := response.Status.Attributes.AsMap().Get("x-ms-request-charge")
+
+	// whole numbers are inferred as Int64 by the untyped parser
+	if charge.Type == graphson.Int64 {
+		return float64(charge.AsInt64())
+	}
+
+	return charge.AsFloat64()
+}
+
+func init() {
+	graphson.RegisterParser("cosmos", GraphSONCosmosParser{})
+}
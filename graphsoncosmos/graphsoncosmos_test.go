@@ -0,0 +1,126 @@
+package graphsoncosmos
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/stretchr/testify/assert"
+)
+
+const vertexCosmos = `{
+  "id" : "thomas",
+  "label" : "person",
+  "type" : "vertex",
+  "properties" : {
+    "firstName" : [ {
+      "id" : "3f1b4f0c-a1b4-4b8e-9f0a-6f9d0f5d2c11",
+      "value" : "Thomas"
+    } ],
+    "age" : [ {
+      "id" : "8b7d7f4e-5b6a-4c1e-8d3f-2e1a9c0b7d44",
+      "value" : 44
+    } ],
+    "pk" : [ {
+      "id" : "thomas|pk",
+      "value" : "pk"
+    } ]
+  }
+}`
+
+const edgeCosmos = `{
+  "id" : "e7c5a6b2-4f0d-4a3e-9b1c-0d2f8e6a5b33",
+  "label" : "knows",
+  "type" : "edge",
+  "inVLabel" : "person",
+  "outVLabel" : "person",
+  "inV" : "mary",
+  "outV" : "thomas",
+  "properties" : {
+    "since" : 2010
+  }
+}`
+
+const responseCosmos = `{
+  "requestId" : "0f4a0c6e-3a4b-4d38-9c69-3c7d2f1e9b88",
+  "status" : {
+    "code" : 200,
+    "attributes" : {
+      "x-ms-status-code" : 200,
+      "x-ms-request-charge" : 2.29,
+      "x-ms-total-request-charge" : 2.29,
+      "x-ms-activity-id" : "d2b1e9a3-7c64-4f0e-8a51-6b3c9d0e2f17"
+    },
+    "message" : ""
+  },
+  "result" : {
+    "data" : [ {
+      "id" : "thomas",
+      "label" : "person",
+      "type" : "vertex",
+      "properties" : {
+        "firstName" : [ {
+          "id" : "3f1b4f0c-a1b4-4b8e-9f0a-6f9d0f5d2c11",
+          "value" : "Thomas"
+        } ]
+      }
+    }, {
+      "id" : "e7c5a6b2-4f0d-4a3e-9b1c-0d2f8e6a5b33",
+      "label" : "knows",
+      "type" : "edge",
+      "inVLabel" : "person",
+      "outVLabel" : "person",
+      "inV" : "mary",
+      "outV" : "thomas"
+    } ],
+    "meta" : { }
+  }
+}`
+
+func TestParseVertex(t *testing.T) {
+	g := GraphSONCosmosParser{}
+
+	vertex, err := g.ParseVertex([]byte(vertexCosmos))
+	assert.Nil(t, err)
+
+	assert.Equal(t, "thomas", vertex.ID.AsString())
+	assert.Equal(t, "person", vertex.Label)
+	assert.Len(t, vertex.Properties, 3)
+
+	firstName := vertex.Properties["firstName"][0]
+	assert.Equal(t, "3f1b4f0c-a1b4-4b8e-9f0a-6f9d0f5d2c11", firstName.ID.AsString())
+	assert.Equal(t, "Thomas", firstName.Value.AsString())
+	assert.Equal(t, "firstName", firstName.Label)
+
+	assert.Equal(t, int64(44), vertex.Properties["age"][0].Value.AsInt64())
+	assert.Equal(t, "44", vertex.Properties["age"][0].ValueString())
+}
+
+func TestParseEdge(t *testing.T) {
+	g := GraphSONCosmosParser{}
+
+	edge, err := g.ParseEdge([]byte(edgeCosmos))
+	assert.Nil(t, err)
+
+	assert.Equal(t, "e7c5a6b2-4f0d-4a3e-9b1c-0d2f8e6a5b33", edge.ID.AsString())
+	assert.Equal(t, "mary", edge.InV.AsString())
+	assert.Equal(t, "thomas", edge.OutV.AsString())
+	assert.Equal(t, int64(2010), edge.Properties["since"].Value.AsInt64())
+}
+
+func TestParseResponse(t *testing.T) {
+	g := GraphSONCosmosParser{}
+
+	response, err := g.ParseResponse([]byte(responseCosmos))
+	assert.Nil(t, err)
+
+	results := response.Data.Value.([]graphson.ValuePair)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "Thomas", results[0].AsVertex().Properties["firstName"][0].Value.AsString())
+	assert.Equal(t, "knows", results[1].AsEdge().Label)
+
+	assert.Equal(t, 2.29, RequestCharge(response))
+}
+
+func TestRegistered(t *testing.T) {
+	assert.IsType(t, GraphSONCosmosParser{}, graphson.NewParser("cosmos"))
+}
@@ -0,0 +1,205 @@
+package graphsonjanus
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+// GeoshapeRecord mirrors JanusGraph's Geoshape, written in a GeoJSON like form. Coordinates are nested as deep as the
+// shape requires: a position is a list of float64 axes, longitude first, and lines, polygons and boxes are lists of
+// positions or of lists of positions.
+type GeoshapeRecord struct {
+	Type        string        `json:"type"`
+	Coordinates []interface{} `json:"coordinates"`
+	Radius      float64       `json:"radius,omitempty"` // kilometers, only set for a Circle
+}
+
+// Point returns the latitude and longitude of a Point, or the center of a Circle. ok is false for any other shape.
+func (g GeoshapeRecord) Point() (lat float64, lon float64, ok bool) {
+	if (g.Type != "Point" && g.Type != "Circle") || len(g.Coordinates) < 2 {
+		return 0, 0, false
+	}
+
+	lon, lonOK := g.Coordinates[0].(float64)
+	lat, latOK := g.Coordinates[1].(float64)
+
+	return lat, lon, lonOK && latOK
+}
+
+func parseGeoshape(p graphson.GraphSONParser, in []byte) (interface{}, error) {
+	var shape GeoshapeRecord
+
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"type"},
+		{"coordinates"},
+		{"radius"},
+		{"properties", "radius"},
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		currentError := graphson.ParsingError{Operation: "parseGeoshape", Field: strings.Join(paths[idx], " ")}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		switch idx {
+		case 0: // type
+			shapeType, e := jsonparser.ParseString(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			shape.Type = shapeType
+
+		case 1: // coordinates
+			coordinates, e := parseCoordinates(value, vt)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			list, ok := coordinates.([]interface{})
+			if !ok {
+				currentError.Message = "coordinates must be an array"
+				break
+			}
+
+			shape.Coordinates = list
+
+		case 2, 3: // radius, properties -> radius
+			radius, e := double(value, vt)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			shape.Radius = radius
+		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+	}, paths...)
+
+	return shape, parsingErrors.Combine()
+}
+
+// parseCoordinates returns a float64 for a single axis or a []interface{} for an array, recursing in to nested arrays
+func parseCoordinates(value []byte, vt jsonparser.ValueType) (interface{}, error) {
+	if vt != jsonparser.Array {
+		return double(value, vt)
+	}
+
+	out := []interface{}{}
+	var itemErr error
+
+	_, err := jsonparser.ArrayEach(value, func(item []byte, dataType jsonparser.ValueType, offset int, err error) {
+		if itemErr != nil {
+			return
+		}
+
+		if err != nil {
+			itemErr = err
+			return
+		}
+
+		coordinate, err := parseCoordinates(item, dataType)
+		if err != nil {
+			itemErr = err
+			return
+		}
+
+		out = append(out, coordinate)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, itemErr
+}
+
+// double reads a number written plainly or as a typed g:Double/g:Float. Coordinates are read from the raw JSON
+// at float64 precision whichever of the two was written.
+func double(value []byte, vt jsonparser.ValueType) (float64, error) {
+	switch vt {
+	case jsonparser.Object:
+		return jsonparser.GetFloat(value, "@value")
+	case jsonparser.Number:
+		return jsonparser.ParseFloat(value)
+	}
+
+	return 0, errors.New("coordinate must be a number")
+}
+
+func writeGeoshape(s graphson.GraphSONSerializer, in interface{}) ([]byte, error) {
+	shape := in.(GeoshapeRecord)
+
+	shapeType, err := json.Marshal(shape.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"type":`)
+	buf.Write(shapeType)
+
+	buf.WriteString(`,"coordinates":`)
+	if err := writeCoordinates(buf, shape.Coordinates); err != nil {
+		return nil, err
+	}
+
+	if shape.Radius != 0 {
+		buf.WriteString(`,"radius":`)
+		writeDouble(buf, shape.Radius)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func writeCoordinates(buf *bytes.Buffer, in interface{}) error {
+	switch c := in.(type) {
+	case float64:
+		writeDouble(buf, c)
+	case []interface{}:
+		buf.WriteByte('[')
+
+		for i, coordinate := range c {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := writeCoordinates(buf, coordinate); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte(']')
+	default:
+		return graphson.ParsingError{Message: "coordinates must be float64 or nested []interface{}", Operation: "serializeGeoshape", Field: "coordinates"}
+	}
+
+	return nil
+}
+
+func writeDouble(buf *bytes.Buffer, in float64) {
+	buf.WriteString(`{"@type":"g:Double","@value":`)
+	buf.WriteString(strconv.FormatFloat(in, 'g', -1, 64))
+	buf.WriteByte('}')
+}
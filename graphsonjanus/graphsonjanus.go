@@ -0,0 +1,60 @@
+// Package graphsonjanus layers the JanusGraph specific types, janusgraph:RelationIdentifier and janusgraph:Geoshape, on
+// top of the GraphSON 3 parser and serializer. Importing the package registers the types with graphson.RegisterType,
+// and the GraphSON 3 parser and serializer under the name "janus".
+package graphsonjanus
+
+import (
+	"github.com/dnoberon/graphson"
+	"github.com/dnoberon/graphson/graphson3"
+)
+
+const relationIdentifierTypeName = "janusgraph:RelationIdentifier"
+const geoshapeTypeName = "janusgraph:Geoshape"
+
+// some clients write geoshapes under the geo: prefix instead
+const geoGeoshapeTypeName = "geo:Geoshape"
+
+var (
+	// RelationIdentifier is the ValueType of a janusgraph:RelationIdentifier, the ID JanusGraph gives edges and vertex
+	// properties.
+	RelationIdentifier = graphson.RegisterType(relationIdentifierTypeName, parseRelationIdentifier, writeRelationIdentifier)
+
+	// Geoshape is the ValueType of a janusgraph:Geoshape.
+	Geoshape = graphson.RegisterType(geoshapeTypeName, parseGeoshape, writeGeoshape)
+)
+
+// NewParser returns a GraphSON 3 parser. The JanusGraph types are registered with every GraphSON 3 parser once this
+// package is imported.
+func NewParser() graphson3.GraphSONv3Parser {
+	return graphson3.GraphSONv3Parser{}
+}
+
+// NewSerializer returns a GraphSON 3 serializer, which writes the JanusGraph types once this package is imported.
+func NewSerializer() graphson3.GraphSONv3Serializer {
+	return graphson3.GraphSONv3Serializer{}
+}
+
+// AsRelationIdentifier returns the RelationIdentifierRecord held by a ValuePair, or the zero value if it holds another type.
+func AsRelationIdentifier(vp graphson.ValuePair) RelationIdentifierRecord {
+	if vp.Type != RelationIdentifier {
+		return RelationIdentifierRecord{}
+	}
+
+	return vp.Value.(RelationIdentifierRecord)
+}
+
+// AsGeoshape returns the GeoshapeRecord held by a ValuePair, or the zero value if it holds another type.
+func AsGeoshape(vp graphson.ValuePair) GeoshapeRecord {
+	if vp.Type != Geoshape {
+		return GeoshapeRecord{}
+	}
+
+	return vp.Value.(GeoshapeRecord)
+}
+
+func init() {
+	graphson.RegisterTypeAlias(geoGeoshapeTypeName, geoshapeTypeName)
+
+	graphson.RegisterParser("janus", NewParser())
+	graphson.RegisterSerializer("janus", NewSerializer())
+}
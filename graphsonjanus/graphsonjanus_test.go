@@ -0,0 +1,175 @@
+package graphsonjanus
+
+import (
+	"testing"
+
+	"github.com/dnoberon/graphson"
+	"github.com/dnoberon/graphson/graphson3"
+	"github.com/stretchr/testify/assert"
+)
+
+const edgeJanus = `{
+  "@type" : "g:Edge",
+  "@value" : {
+    "id" : {
+      "@type" : "janusgraph:RelationIdentifier",
+      "@value" : {
+        "relationId" : "4r6-39s-69h-3bc"
+      }
+    },
+    "label" : "lives",
+    "inVLabel" : "location",
+    "outVLabel" : "god",
+    "inV" : {
+      "@type" : "g:Int64",
+      "@value" : 4328
+    },
+    "outV" : {
+      "@type" : "g:Int64",
+      "@value" : 4320
+    },
+    "properties" : {
+      "place" : {
+        "@type" : "g:Property",
+        "@value" : {
+          "key" : "place",
+          "value" : {
+            "@type" : "janusgraph:Geoshape",
+            "@value" : {
+              "type" : "Point",
+              "coordinates" : [ {
+                "@type" : "g:Double",
+                "@value" : 22.0
+              }, {
+                "@type" : "g:Double",
+                "@value" : 39.0
+              } ]
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+const relationIdentifierLegacy = `{
+  "@type" : "janusgraph:RelationIdentifier",
+  "@value" : {
+    "relationId" : 6162,
+    "typeId" : 8117,
+    "outVertexId" : 4240,
+    "inVertexId" : 4296
+  }
+}`
+
+const geoshapeCircle = `{
+  "@type" : "janusgraph:Geoshape",
+  "@value" : {
+    "type" : "Circle",
+    "radius" : 50.5,
+    "coordinates" : [ 37.97, 23.72 ]
+  }
+}`
+
+const geoshapePolygon = `{
+  "@type" : "janusgraph:Geoshape",
+  "@value" : {
+    "type" : "Polygon",
+    "coordinates" : [ [ [ 119.0, 59.0 ], [ 121.0, 59.0 ], [ 121.0, 61.0 ], [ 119.0, 61.0 ], [ 119.0, 59.0 ] ] ]
+  }
+}`
+
+func TestParseEdgeRelationIdentifier(t *testing.T) {
+	g := NewParser()
+
+	edge, err := g.ParseEdge([]byte(edgeJanus))
+	assert.Nil(t, err)
+
+	id := edge.ID
+	assert.Equal(t, RelationIdentifier, id.Type)
+	assert.Equal(t, RelationIdentifierRecord{RelationID: 6162, OutVertexID: 4240, TypeID: 8117, InVertexID: 4296}, AsRelationIdentifier(id))
+	assert.Equal(t, "4r6-39s-69h-3bc", AsRelationIdentifier(id).String())
+
+	lat, lon, ok := AsGeoshape(edge.Properties["place"].Value).Point()
+	assert.True(t, ok)
+	assert.Equal(t, 39.0, lat)
+	assert.Equal(t, 22.0, lon)
+}
+
+func TestParseRelationIdentifierLegacy(t *testing.T) {
+	vp, err := NewParser().Parse([]byte(relationIdentifierLegacy))
+	assert.Nil(t, err)
+	assert.Equal(t, RelationIdentifier, vp.Type)
+	assert.Equal(t, "4r6-39s-69h-3bc", AsRelationIdentifier(vp).String())
+
+	_, err = ParseRelationIdentifier("4r6")
+	assert.NotNil(t, err)
+}
+
+func TestParseGeoshape(t *testing.T) {
+	g := NewParser()
+
+	vp, err := g.Parse([]byte(geoshapeCircle))
+	assert.Nil(t, err)
+
+	circle := AsGeoshape(vp)
+	assert.Equal(t, "Circle", circle.Type)
+	assert.Equal(t, 50.5, circle.Radius)
+
+	vp, err = g.Parse([]byte(geoshapePolygon))
+	assert.Nil(t, err)
+
+	polygon := AsGeoshape(vp)
+	assert.Len(t, polygon.Coordinates, 1)
+	assert.Len(t, polygon.Coordinates[0], 5)
+
+	_, _, ok := polygon.Point()
+	assert.False(t, ok)
+}
+
+func TestRegisteredTypes(t *testing.T) {
+	// the types are registered with every GraphSON 3 parser, not only the one returned by NewParser
+	vp, err := graphson3.GraphSONv3Parser{}.Parse([]byte(geoshapeCircle))
+	assert.Nil(t, err)
+	assert.Equal(t, Geoshape, vp.Type)
+
+	vp, err = graphson3.GraphSONv3Parser{}.Parse([]byte(`{"@type":"geo:Geoshape","@value":{"type":"Point","coordinates":[22.0,39.0]}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, Geoshape, vp.Type)
+
+	out, err := graphson3.GraphSONv3Serializer{}.Serialize(vp)
+	assert.Nil(t, err)
+	assert.Contains(t, string(out), `"@type":"janusgraph:Geoshape"`)
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	g := NewParser()
+	s := NewSerializer()
+
+	edge, err := g.ParseEdge([]byte(edgeJanus))
+	assert.Nil(t, err)
+
+	out, err := s.SerializeEdge(edge)
+	assert.Nil(t, err)
+
+	reparsed, err := g.ParseEdge(out)
+	assert.Nil(t, err)
+	assert.Equal(t, edge, reparsed)
+
+	for _, in := range []string{relationIdentifierLegacy, geoshapeCircle, geoshapePolygon} {
+		original, err := g.Parse([]byte(in))
+		assert.Nil(t, err)
+
+		out, err := s.Serialize(original)
+		assert.Nil(t, err)
+
+		reparsed, err := g.Parse(out)
+		assert.Nil(t, err)
+		assert.Equal(t, original, reparsed)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	assert.IsType(t, graphson3.GraphSONv3Parser{}, graphson.NewParser("janus"))
+	assert.IsType(t, graphson3.GraphSONv3Serializer{}, graphson.NewSerializer("janus"))
+}
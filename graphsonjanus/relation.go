@@ -0,0 +1,153 @@
+package graphsonjanus
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/dnoberon/graphson"
+
+	"github.com/buger/jsonparser"
+)
+
+// RelationIdentifierRecord mirrors JanusGraph's RelationIdentifier. An edge is identified by its own relation ID along
+// with its label's type ID and the IDs of the vertices it joins; a vertex property has no InVertexID.
+type RelationIdentifierRecord struct {
+	RelationID  int64 `json:"relationId"`
+	TypeID      int64 `json:"typeId"`
+	OutVertexID int64 `json:"outVertexId"`
+	InVertexID  int64 `json:"inVertexId"`
+}
+
+// String returns the identifier the way JanusGraph writes it, each ID in base 36 joined by dashes, e.g. 4r6-39s-69h-3bc.
+// This is also the form g.E(id) accepts.
+func (r RelationIdentifierRecord) String() string {
+	parts := []string{
+		strconv.FormatInt(r.RelationID, 36),
+		strconv.FormatInt(r.OutVertexID, 36),
+		strconv.FormatInt(r.TypeID, 36),
+	}
+
+	if r.InVertexID != 0 {
+		parts = append(parts, strconv.FormatInt(r.InVertexID, 36))
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// ParseRelationIdentifier reads the dashed base 36 form returned by RelationIdentifierRecord.String.
+func ParseRelationIdentifier(in string) (r RelationIdentifierRecord, err error) {
+	parts := strings.Split(in, "-")
+	if len(parts) != 3 && len(parts) != 4 {
+		return r, errors.New("relation identifier must have three or four parts")
+	}
+
+	ids := make([]int64, 4)
+	for i, part := range parts {
+		if ids[i], err = strconv.ParseInt(part, 36, 64); err != nil {
+			return r, err
+		}
+	}
+
+	return RelationIdentifierRecord{RelationID: ids[0], OutVertexID: ids[1], TypeID: ids[2], InVertexID: ids[3]}, nil
+}
+
+// parseRelationIdentifier accepts both the current form, where relationId holds the dashed string, and the older form
+// where each ID is its own, possibly typed, number
+func parseRelationIdentifier(p graphson.GraphSONParser, in []byte) (interface{}, error) {
+	var r RelationIdentifierRecord
+
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"relationId"},
+		{"typeId"},
+		{"outVertexId"},
+		{"inVertexId"},
+	}
+
+	parsingErrors := graphson.ParsingErrors{}
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		currentError := graphson.ParsingError{Operation: "parseRelationIdentifier", Field: strings.Join(paths[idx], " ")}
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		if idx == 0 && vt == jsonparser.String { // relationId, as a dashed string
+			id, e := ParseRelationIdentifier(string(value))
+			if e != nil {
+				currentError.Message = e.Error()
+				parsingErrors = append(parsingErrors, currentError)
+				return
+			}
+
+			// the separate IDs of the older form may have been visited first, only fill in what they left empty
+			r = merge(id, r)
+			return
+		}
+
+		id, e := long(value, vt)
+		if e != nil {
+			currentError.Message = e.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		switch idx {
+		case 0: // relationId
+			r.RelationID = id
+		case 1: // typeId
+			r.TypeID = id
+		case 2: // outVertexId
+			r.OutVertexID = id
+		case 3: // inVertexId
+			r.InVertexID = id
+		}
+	}, paths...)
+
+	return r, parsingErrors.Combine()
+}
+
+func merge(parsed, explicit RelationIdentifierRecord) RelationIdentifierRecord {
+	if explicit.TypeID != 0 {
+		parsed.TypeID = explicit.TypeID
+	}
+
+	if explicit.OutVertexID != 0 {
+		parsed.OutVertexID = explicit.OutVertexID
+	}
+
+	if explicit.InVertexID != 0 {
+		parsed.InVertexID = explicit.InVertexID
+	}
+
+	return parsed
+}
+
+// long reads an ID written as a plain number or as a typed g:Int32/g:Int64
+func long(value []byte, vt jsonparser.ValueType) (int64, error) {
+	if vt == jsonparser.Object {
+		return jsonparser.GetInt(value, "@value")
+	}
+
+	return jsonparser.ParseInt(value)
+}
+
+func writeRelationIdentifier(s graphson.GraphSONSerializer, in interface{}) ([]byte, error) {
+	id, err := json.Marshal(in.(RelationIdentifierRecord).String())
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"relationId":`)
+	buf.Write(id)
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
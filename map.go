@@ -0,0 +1,113 @@
+package graphson
+
+import (
+	"reflect"
+)
+
+// MapRecord mirrors the g:Map type. Unlike a Go map it preserves the order entries were written in, which matters for
+// the results of order() and group(), and allows keys such as vertices and lists that Go cannot hash.
+type MapRecord struct {
+	Entries []MapEntry `json:"entries"`
+}
+
+// MapEntry is a single key/value pair of a MapRecord.
+type MapEntry struct {
+	Key   ValuePair `json:"key"`
+	Value ValuePair `json:"value"`
+}
+
+// Len returns the number of entries in the map.
+func (m MapRecord) Len() int {
+	return len(m.Entries)
+}
+
+// Keys returns every key in the map, in order.
+func (m MapRecord) Keys() []ValuePair {
+	out := make([]ValuePair, 0, len(m.Entries))
+
+	for _, entry := range m.Entries {
+		out = append(out, entry.Key)
+	}
+
+	return out
+}
+
+// Get returns the value of the first entry whose key is a string, or a Gremlin enum token such as T.id, equal to key.
+func (m MapRecord) Get(key string) (ValuePair, bool) {
+	for _, entry := range m.Entries {
+		if name, ok := keyName(entry.Key); ok && name == key {
+			return entry.Value, true
+		}
+	}
+
+	return ValuePair{}, false
+}
+
+// Lookup returns the value of the first entry whose key is deeply equal to key, for maps keyed by vertices, lists and
+// other values that can't be looked up by name.
+func (m MapRecord) Lookup(key ValuePair) (ValuePair, bool) {
+	for _, entry := range m.Entries {
+		if reflect.DeepEqual(entry.Key, key) {
+			return entry.Value, true
+		}
+	}
+
+	return ValuePair{}, false
+}
+
+func keyName(key ValuePair) (string, bool) {
+	switch v := key.Value.(type) {
+	case string:
+		return v, key.Type == String
+	case TToken:
+		return string(v), true
+	case DirectionToken:
+		return string(v), true
+	case CardinalityToken:
+		return string(v), true
+	case OrderToken:
+		return string(v), true
+	case PopToken:
+		return string(v), true
+	case ScopeToken:
+		return string(v), true
+	case ColumnToken:
+		return string(v), true
+	case BarrierToken:
+		return string(v), true
+	case OperatorToken:
+		return string(v), true
+	case PickToken:
+		return string(v), true
+	}
+
+	return "", false
+}
+
+// hashable reports whether v can be used as a Go map key without panicking. Comparable isn't enough as it only looks at
+// the static type, a struct such as TraverserRecord is comparable yet panics when the ValuePair it holds has a slice
+// inside, so the values held by interfaces are checked too.
+func hashable(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Slice, reflect.Map, reflect.Func:
+		return false
+	case reflect.Interface:
+		return v.IsNil() || hashable(v.Elem())
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !hashable(v.Index(i)) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !hashable(v.Field(i)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
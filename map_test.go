@@ -0,0 +1,25 @@
+package graphson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsFlatMapUnhashableKeys(t *testing.T) {
+	list := ValuePair{Type: List, Value: []ValuePair{{Type: String, Value: "a"}}}
+	one := ValuePair{Type: Int64, Value: int64(1)}
+
+	m := ValuePair{Type: Map, Value: MapRecord{Entries: []MapEntry{
+		{Key: ValuePair{Type: String, Value: "name"}, Value: one},
+		{Key: list, Value: one},
+		{Key: ValuePair{Type: Traverser, Value: TraverserRecord{Bulk: 1, Value: list}}, Value: one},
+		{Key: ValuePair{Type: EdgeProperty, Value: Property{Key: "tags", Value: list}}, Value: one},
+		{Key: ValuePair{Type: Traverser, Value: TraverserRecord{Bulk: 1, Value: one}}, Value: one},
+	}}}
+
+	flat := m.AsFlatMap()
+	assert.Len(t, flat, 2)
+	assert.Equal(t, one, flat["name"])
+	assert.Equal(t, one, flat[TraverserRecord{Bulk: 1, Value: one}])
+}
@@ -0,0 +1,473 @@
+package graphson
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Marshal returns the ValuePair for a Go value, the inverse of Unmarshal, ready to be serialized or sent as a binding.
+// Each Go type is given the ValueType a parser would have returned for it. int and int32 become Int32, int64 Int64,
+// float32 Float, float64 Double, time.Time Timestamp, slices and arrays List, maps and structs Map, and the records and
+// tokens of this package their own types. Struct fields are named by their `graphson:"name"` tag, or their name if
+// untagged, the fields of untagged embedded structs are promoted and nil pointers are left out.
+func Marshal(in interface{}) (ValuePair, error) {
+	return marshalValue(reflect.ValueOf(in), "")
+}
+
+// MarshalVertex returns the VertexRecord for a struct tagged as Unmarshal expects. The fields tagged "id" and "label"
+// fill the vertex's ID and label and every other field becomes a property, a slice becoming one property per element.
+// A struct field with a field tagged "value" becomes a property with its other fields as meta-properties.
+func MarshalVertex(in interface{}) (VertexRecord, error) {
+	vertex := VertexRecord{Properties: map[string][]VertexPropertyRecord{}}
+
+	err := eachField(reflect.ValueOf(in), func(name string, field reflect.Value) error {
+		var err error
+
+		switch name {
+		case "id":
+			vertex.ID, err = marshalValue(field, name)
+		case "label":
+			vertex.Label, err = marshalLabel(field, name)
+		default:
+			// fields sharing a property name add to each other, as Unmarshal fills each of them
+			var properties []VertexPropertyRecord
+			if properties, err = marshalVertexProperties(name, field); len(properties) > 0 {
+				vertex.Properties[name] = append(vertex.Properties[name], properties...)
+			}
+		}
+
+		return err
+	})
+
+	return vertex, err
+}
+
+// MarshalEdge returns the EdgeRecord for a struct tagged as Unmarshal expects. The fields tagged "id", "label", "inV",
+// "outV", "inVLabel" and "outVLabel" fill the edge itself and every other field becomes a property.
+func MarshalEdge(in interface{}) (EdgeRecord, error) {
+	edge := EdgeRecord{Properties: map[string]Property{}}
+
+	err := eachField(reflect.ValueOf(in), func(name string, field reflect.Value) error {
+		var err error
+
+		switch name {
+		case "id":
+			edge.ID, err = marshalValue(field, name)
+		case "label":
+			edge.Label, err = marshalLabel(field, name)
+		case "inV":
+			edge.InV, err = marshalValue(field, name)
+		case "outV":
+			edge.OutV, err = marshalValue(field, name)
+		case "inVLabel":
+			edge.InVLabel, err = marshalLabel(field, name)
+		case "outVLabel":
+			edge.OutVLabel, err = marshalLabel(field, name)
+		default:
+			property := Property{Key: name}
+			property.Value, err = marshalValue(field, name)
+			edge.Properties[name] = property
+		}
+
+		return err
+	})
+
+	return edge, err
+}
+
+func marshalValue(rv reflect.Value, field string) (ValuePair, error) {
+	if !rv.IsValid() {
+		return ValuePair{}, ParsingError{Message: "cannot marshal nil", Operation: "marshal", Field: field}
+	}
+
+	switch v := rv.Interface().(type) {
+	case ValuePair:
+		return v, nil
+	case VertexRecord:
+		return ValuePair{Type: Vertex, Value: v}, nil
+	case VertexPropertyRecord:
+		return ValuePair{Type: VertexProperty, Value: v}, nil
+	case EdgeRecord:
+		return ValuePair{Type: Edge, Value: v}, nil
+	case Property:
+		return ValuePair{Type: EdgeProperty, Value: v}, nil
+	case PathRecord:
+		return ValuePair{Type: Path, Value: v}, nil
+	case MapRecord:
+		return ValuePair{Type: Map, Value: v}, nil
+	case time.Time:
+		return ValuePair{Type: Timestamp, Value: v}, nil
+	case time.Duration:
+		return ValuePair{Type: Duration, Value: v}, nil
+	case []byte:
+		return ValuePair{Type: ByteBuffer, Value: v}, nil
+	case *big.Int:
+		return ValuePair{Type: BigInteger, Value: v}, nil
+	case *big.Float:
+		return ValuePair{Type: BigDecimal, Value: v}, nil
+	case LocalDateRecord:
+		return ValuePair{Type: LocalDate, Value: v}, nil
+	case LocalDateTimeRecord:
+		return ValuePair{Type: LocalDateTime, Value: v}, nil
+	case LocalTimeRecord:
+		return ValuePair{Type: LocalTime, Value: v}, nil
+	case MonthDayRecord:
+		return ValuePair{Type: MonthDay, Value: v}, nil
+	case OffsetTimeRecord:
+		return ValuePair{Type: OffsetTime, Value: v}, nil
+	case PeriodRecord:
+		return ValuePair{Type: Period, Value: v}, nil
+	case YearMonthRecord:
+		return ValuePair{Type: YearMonth, Value: v}, nil
+	case ZoneOffsetRecord:
+		return ValuePair{Type: ZoneOffset, Value: v}, nil
+	case TToken:
+		return ValuePair{Type: T, Value: v}, nil
+	case DirectionToken:
+		return ValuePair{Type: Direction, Value: v}, nil
+	case CardinalityToken:
+		return ValuePair{Type: Cardinality, Value: v}, nil
+	case OrderToken:
+		return ValuePair{Type: Order, Value: v}, nil
+	case PopToken:
+		return ValuePair{Type: Pop, Value: v}, nil
+	case ScopeToken:
+		return ValuePair{Type: Scope, Value: v}, nil
+	case ColumnToken:
+		return ValuePair{Type: Column, Value: v}, nil
+	case BarrierToken:
+		return ValuePair{Type: Barrier, Value: v}, nil
+	case OperatorToken:
+		return ValuePair{Type: Operator, Value: v}, nil
+	case PickToken:
+		return ValuePair{Type: Pick, Value: v}, nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return ValuePair{}, ParsingError{Message: "cannot marshal nil", Operation: "marshal", Field: field}
+		}
+
+		return marshalValue(rv.Elem(), field)
+	case reflect.String:
+		return ValuePair{Type: String, Value: rv.String()}, nil
+	case reflect.Bool:
+		return ValuePair{Type: Boolean, Value: rv.Bool()}, nil
+	case reflect.Int8:
+		return ValuePair{Type: Byte, Value: int8(rv.Int())}, nil
+	case reflect.Int16, reflect.Uint8:
+		return ValuePair{Type: Short, Value: int16(toInt64(rv))}, nil
+	case reflect.Int, reflect.Int32, reflect.Uint16:
+		n := toInt64(rv)
+		if n > math.MaxInt32 || n < math.MinInt32 {
+			return ValuePair{}, ParsingError{Message: fmt.Sprintf("%d overflows Int32, use int64", n), Operation: "marshal", Field: field}
+		}
+
+		return ValuePair{Type: Int32, Value: int(n)}, nil
+	case reflect.Int64, reflect.Uint32:
+		return ValuePair{Type: Int64, Value: toInt64(rv)}, nil
+	case reflect.Uint, reflect.Uint64:
+		if n := rv.Uint(); n > math.MaxInt64 {
+			return ValuePair{Type: BigInteger, Value: new(big.Int).SetUint64(n)}, nil
+		}
+
+		return ValuePair{Type: Int64, Value: int64(rv.Uint())}, nil
+	case reflect.Float32:
+		return ValuePair{Type: Float, Value: float32(rv.Float())}, nil
+	case reflect.Float64:
+		return ValuePair{Type: Double, Value: rv.Float()}, nil
+	case reflect.Slice, reflect.Array:
+		out := make([]ValuePair, 0, rv.Len())
+
+		for i := 0; i < rv.Len(); i++ {
+			vp, err := marshalValue(rv.Index(i), fmt.Sprintf("%s[%d]", field, i))
+			if err != nil {
+				return ValuePair{}, err
+			}
+
+			out = append(out, vp)
+		}
+
+		return ValuePair{Type: List, Value: out}, nil
+	case reflect.Map:
+		return marshalMap(rv, field)
+	case reflect.Struct:
+		m := MapRecord{}
+
+		err := eachField(rv, func(name string, value reflect.Value) error {
+			vp, err := marshalValue(value, joinField(field, name))
+			m.Entries = append(m.Entries, MapEntry{Key: ValuePair{Type: String, Value: name}, Value: vp})
+
+			return err
+		})
+
+		return ValuePair{Type: Map, Value: m}, err
+	}
+
+	return ValuePair{}, ParsingError{Message: fmt.Sprintf("cannot marshal Go type %v", rv.Type()), Operation: "marshal", Field: field}
+}
+
+// marshalMap writes a Go map as a Map, ordering its entries by key so that the output is the same on every run.
+func marshalMap(rv reflect.Value, field string) (ValuePair, error) {
+	keys := rv.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+
+	m := MapRecord{Entries: make([]MapEntry, 0, len(keys))}
+
+	for _, key := range keys {
+		name := joinField(field, fmt.Sprint(key.Interface()))
+
+		k, err := marshalValue(key, name)
+		if err != nil {
+			return ValuePair{}, err
+		}
+
+		v, err := marshalValue(rv.MapIndex(key), name)
+		if err != nil {
+			return ValuePair{}, err
+		}
+
+		m.Entries = append(m.Entries, MapEntry{Key: k, Value: v})
+	}
+
+	return ValuePair{Type: Map, Value: m}, nil
+}
+
+func marshalVertexProperties(name string, rv reflect.Value) ([]VertexPropertyRecord, error) {
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+
+	// every element of a slice is a property of its own, multi-cardinality, byte slices being a single value
+	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Type().Elem().Kind() != reflect.Uint8 {
+		out := make([]VertexPropertyRecord, 0, rv.Len())
+
+		for i := 0; i < rv.Len(); i++ {
+			property, err := marshalVertexProperty(name, rv.Index(i), fmt.Sprintf("%s[%d]", name, i))
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, property)
+		}
+
+		return out, nil
+	}
+
+	property, err := marshalVertexProperty(name, rv, name)
+
+	return []VertexPropertyRecord{property}, err
+}
+
+func marshalVertexProperty(label string, rv reflect.Value, field string) (VertexPropertyRecord, error) {
+	property := VertexPropertyRecord{Label: label, Properties: map[string]ValuePair{}}
+
+	if !hasValueField(rv) {
+		vp, err := marshalValue(rv, field)
+		property.Value = vp
+
+		return property, err
+	}
+
+	err := eachField(rv, func(name string, value reflect.Value) error {
+		var err error
+
+		switch name {
+		case "id":
+			property.ID, err = marshalValue(value, joinField(field, name))
+		case "label":
+			property.Label, err = marshalLabel(value, joinField(field, name))
+		case "value":
+			property.Value, err = marshalValue(value, joinField(field, name))
+		default:
+			property.Properties[name], err = marshalValue(value, joinField(field, name))
+		}
+
+		return err
+	})
+
+	return property, err
+}
+
+// hasValueField reports whether rv is a struct describing a vertex property and its meta-properties.
+func hasValueField(rv reflect.Value) bool {
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return false
+	}
+
+	for _, f := range structFields(rv.Type()) {
+		if f.name == "value" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func marshalLabel(rv reflect.Value, field string) (string, error) {
+	if rv.Kind() != reflect.String {
+		return "", ParsingError{Message: fmt.Sprintf("label must be a string, not %v", rv.Type()), Operation: "marshal", Field: field}
+	}
+
+	return rv.String(), nil
+}
+
+// eachField calls fn for every exported field of a struct, by the name Unmarshal would match it with. Nil pointers and
+// interfaces are skipped.
+func eachField(rv reflect.Value, fn func(name string, value reflect.Value) error) error {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return ParsingError{Message: "cannot marshal nil", Operation: "marshal"}
+		}
+
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return ParsingError{Message: fmt.Sprintf("expected a struct, not %v", rv.Type()), Operation: "marshal"}
+	}
+
+	for _, f := range structFields(rv.Type()) {
+		// the field of an embedded struct left nil isn't there to marshal
+		value, err := rv.FieldByIndexErr(f.index)
+		if err != nil {
+			continue
+		}
+
+		if (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) && value.IsNil() {
+			continue
+		}
+
+		if err := fn(f.name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// structField is a field of a struct by the name Marshal and Unmarshal match it with, and its index as taken by
+// reflect.Value.FieldByIndex.
+type structField struct {
+	name  string
+	index []int
+}
+
+// structFields lists the fields of a struct type, promoting the fields of untagged embedded structs as encoding/json
+// does. A promoted field is shadowed by any field of the same name nearer the outer struct.
+func structFields(t reflect.Type) []structField {
+	type embedded struct {
+		t     reflect.Type
+		index []int
+	}
+
+	out := []structField{}
+	seen := map[string]bool{}
+	visited := map[reflect.Type]bool{}
+
+	// walk the embedded structs a level at a time so that shallower fields are seen first
+	for level := []embedded{{t: t}}; len(level) > 0; {
+		next := []embedded{}
+		names := []string{}
+
+		for _, e := range level {
+			if visited[e.t] {
+				continue
+			}
+
+			visited[e.t] = true
+
+			for i := 0; i < e.t.NumField(); i++ {
+				f := e.t.Field(i)
+				index := append(append([]int{}, e.index...), i)
+
+				if et, ok := embeddedStruct(f); ok {
+					next = append(next, embedded{t: et, index: index})
+					continue
+				}
+
+				name, ok := fieldName(f)
+				if !ok || seen[name] {
+					continue
+				}
+
+				names = append(names, name)
+				out = append(out, structField{name: name, index: index})
+			}
+		}
+
+		for _, name := range names {
+			seen[name] = true
+		}
+
+		level = next
+	}
+
+	return out
+}
+
+// embeddedStruct returns the struct type of an untagged embedded field whose fields are promoted. An unexported
+// embedded pointer is left out altogether as it can't be allocated.
+func embeddedStruct(f reflect.StructField) (reflect.Type, bool) {
+	if !f.Anonymous {
+		return nil, false
+	}
+
+	if _, ok := f.Tag.Lookup("graphson"); ok {
+		return nil, false
+	}
+
+	t := f.Type
+	if t.Kind() == reflect.Ptr {
+		if f.PkgPath != "" {
+			return nil, false
+		}
+
+		t = t.Elem()
+	}
+
+	return t, t.Kind() == reflect.Struct
+}
+
+// fieldName returns the name a struct field is matched by, false if it's unexported or tagged "-".
+func fieldName(f reflect.StructField) (string, bool) {
+	if f.PkgPath != "" {
+		return "", false
+	}
+
+	name := f.Name
+	if tag, ok := f.Tag.Lookup("graphson"); ok {
+		name = strings.Split(tag, ",")[0]
+	}
+
+	return name, name != "-"
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
+
+func toInt64(rv reflect.Value) int64 {
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int64(rv.Uint())
+	}
+
+	return rv.Int()
+}
@@ -0,0 +1,123 @@
+package graphson
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalValues(t *testing.T) {
+	when := time.Unix(1481750076, 295000000)
+	str := func(s string) ValuePair { return ValuePair{Type: String, Value: s} }
+
+	vp, err := Marshal(struct {
+		Count  int32                 `graphson:"count"`
+		Total  int64                 `graphson:"total"`
+		Score  float64               `graphson:"score"`
+		Ratio  float32               `graphson:"ratio"`
+		When   time.Time             `graphson:"when"`
+		Tags   []string              `graphson:"tags"`
+		Counts map[string]int        `graphson:"counts"`
+		Key    TToken                `graphson:"key"`
+		Extra  *string               `graphson:"extra"`
+		ID     ValuePair             `graphson:"id"`
+		Nested map[int64][]time.Time `graphson:"-"`
+	}{
+		Count:  1,
+		Total:  2,
+		Score:  0.5,
+		Ratio:  0.25,
+		When:   when,
+		Tags:   []string{"a", "b"},
+		Counts: map[string]int{"b": 2, "a": 1},
+		Key:    TLabel,
+		ID:     ValuePair{Type: UUID, Value: "41d2e28a-20a4-4ab0-b379-d810dede3786"},
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, ValuePair{Type: Map, Value: MapRecord{Entries: []MapEntry{
+		{Key: str("count"), Value: ValuePair{Type: Int32, Value: 1}},
+		{Key: str("total"), Value: ValuePair{Type: Int64, Value: int64(2)}},
+		{Key: str("score"), Value: ValuePair{Type: Double, Value: 0.5}},
+		{Key: str("ratio"), Value: ValuePair{Type: Float, Value: float32(0.25)}},
+		{Key: str("when"), Value: ValuePair{Type: Timestamp, Value: when}},
+		{Key: str("tags"), Value: ValuePair{Type: List, Value: []ValuePair{str("a"), str("b")}}},
+		{Key: str("counts"), Value: ValuePair{Type: Map, Value: MapRecord{Entries: []MapEntry{
+			{Key: str("a"), Value: ValuePair{Type: Int32, Value: 1}},
+			{Key: str("b"), Value: ValuePair{Type: Int32, Value: 2}},
+		}}}},
+		{Key: str("key"), Value: ValuePair{Type: T, Value: TLabel}},
+		{Key: str("id"), Value: ValuePair{Type: UUID, Value: "41d2e28a-20a4-4ab0-b379-d810dede3786"}},
+	}}}, vp)
+
+	_, err = Marshal(int(1) << 40)
+	assert.NotNil(t, err, "overflows Int32")
+
+	_, err = Marshal(nil)
+	assert.NotNil(t, err)
+
+	bindings := map[string]ValuePair{}
+	bindings["x"], err = Marshal(int64(1))
+	assert.Nil(t, err)
+	assert.Equal(t, ValuePair{Type: Int64, Value: int64(1)}, bindings["x"])
+}
+
+func TestMarshalVertex(t *testing.T) {
+	endTime := 2001
+	vertex, err := MarshalVertex(testPerson{
+		ID:    1,
+		Label: "person",
+		Locations: []testLocation{
+			{Name: "san diego", StartTime: 1997, EndTime: &endTime},
+			{Name: "santa fe", StartTime: 2005},
+		},
+		Ignored: "left out",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, ValuePair{Type: Int64, Value: int64(1)}, vertex.ID)
+	assert.Equal(t, "person", vertex.Label)
+	assert.Len(t, vertex.Properties["location"], 2)
+	assert.Equal(t, "santa fe", vertex.Properties["location"][1].Value.AsString())
+	assert.Equal(t, ValuePair{Type: Int32, Value: 1997}, vertex.Properties["location"][0].Properties["startTime"])
+	assert.NotContains(t, vertex.Properties["location"][1].Properties, "endTime", "nil pointers are left out")
+	assert.NotContains(t, vertex.Properties, "age")
+	assert.NotContains(t, vertex.Properties, "Ignored")
+}
+
+func TestMarshalEdge(t *testing.T) {
+	edge, err := MarshalEdge(struct {
+		Label string `graphson:"label"`
+		In    int32  `graphson:"inV"`
+		Out   int32  `graphson:"outV"`
+		Since int    `graphson:"since"`
+	}{"develops", 10, 1, 2009})
+	assert.Nil(t, err)
+
+	assert.Equal(t, EdgeRecord{
+		Label:      "develops",
+		InV:        ValuePair{Type: Int32, Value: 10},
+		OutV:       ValuePair{Type: Int32, Value: 1},
+		Properties: map[string]Property{"since": {Key: "since", Value: ValuePair{Type: Int32, Value: 2009}}},
+	}, edge)
+
+	_, err = MarshalEdge(struct {
+		Label int `graphson:"label"`
+	}{})
+	assert.NotNil(t, err)
+}
+
+func TestMarshalEmbedded(t *testing.T) {
+	vp, err := Marshal(testEmbedding{testNamed: testNamed{Label: "person", Name: "shadowed"}, Name: "marko"})
+	assert.Nil(t, err)
+
+	m := vp.AsMap()
+	assert.Len(t, m.Entries, 2, "a nil embedded pointer is left out")
+
+	label, _ := m.Get("label")
+	assert.Equal(t, "person", label.AsString())
+
+	name, _ := m.Get("name")
+	assert.Equal(t, "marko", name.AsString())
+}
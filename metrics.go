@@ -0,0 +1,100 @@
+package graphson
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// TraversalMetricsRecord mirrors the TraversalMetrics record returned by the profile() step.
+type TraversalMetricsRecord struct {
+	Duration time.Duration   `json:"dur"`
+	Metrics  []MetricsRecord `json:"metrics"`
+}
+
+// MetricsRecord mirrors the Metrics record describing a single step of a profiled traversal. Steps that contain child
+// traversals report them in Metrics.
+type MetricsRecord struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Duration    time.Duration        `json:"dur"`
+	Counts      map[string]int64     `json:"counts"`
+	Annotations map[string]ValuePair `json:"annotations"`
+	Metrics     []MetricsRecord      `json:"metrics"`
+}
+
+// Count and annotation keys written by TinkerPop's own profiling.
+const (
+	MetricsElementCount   = "elementCount"
+	MetricsTraverserCount = "traverserCount"
+	MetricsPercentDur     = "percentDur"
+)
+
+// WriteTable writes the per step timing table the Gremlin console prints for profile() results. Child metrics are
+// indented beneath the step that contains them.
+func (t TraversalMetricsRecord) WriteTable(w io.Writer) error {
+	const row = "%-70s %12s %12s %16s %9s\n"
+
+	if _, err := fmt.Fprintf(w, "Traversal Metrics\n"+row, "Step", "Count", "Traversers", "Time (ms)", "% Dur"); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w, strings.Repeat("=", 123)); err != nil {
+		return err
+	}
+
+	if err := writeMetricsRows(w, row, t.Metrics, 0); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, row, strings.Repeat(" ", 40)+">TOTAL", "-", "-", formatMillis(t.Duration), "-")
+
+	return err
+}
+
+// String returns the same table as WriteTable.
+func (t TraversalMetricsRecord) String() string {
+	out := &strings.Builder{}
+	_ = t.WriteTable(out)
+
+	return out.String()
+}
+
+func writeMetricsRows(w io.Writer, row string, metrics []MetricsRecord, depth int) error {
+	for _, m := range metrics {
+		percent := ""
+		if p, ok := m.Annotations[MetricsPercentDur]; ok {
+			switch v := p.Value.(type) {
+			case float32:
+				percent = fmt.Sprintf("%.2f", v)
+			case float64:
+				percent = fmt.Sprintf("%.2f", v)
+			}
+		}
+
+		count, traversers := "", ""
+		if c, ok := m.Counts[MetricsElementCount]; ok {
+			count = fmt.Sprint(c)
+		}
+
+		if c, ok := m.Counts[MetricsTraverserCount]; ok {
+			traversers = fmt.Sprint(c)
+		}
+
+		name := strings.Repeat("  ", depth) + m.Name
+		if _, err := fmt.Fprintf(w, row, name, count, traversers, formatMillis(m.Duration), percent); err != nil {
+			return err
+		}
+
+		if err := writeMetricsRows(w, row, m.Metrics, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func formatMillis(d time.Duration) string {
+	return fmt.Sprintf("%.3f", float64(d)/float64(time.Millisecond))
+}
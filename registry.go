@@ -0,0 +1,85 @@
+package graphson
+
+import "sync"
+
+var (
+	customTypesMu sync.RWMutex
+	customByName  = make(map[string]customType)
+	customByType  = make(map[ValueType]customType)
+)
+
+// TypeDecoder parses the @value of a registered type, given as the raw JSON found in the document. The parser is passed
+// along so that nested values can be parsed with the same parser.
+type TypeDecoder func(p GraphSONParser, value []byte) (interface{}, error)
+
+// TypeEncoder writes the Value of a registered type as the JSON to place in @value, the serializer adding the @type.
+type TypeEncoder func(s GraphSONSerializer, value interface{}) ([]byte, error)
+
+type customType struct {
+	name   string
+	vt     ValueType
+	decode TypeDecoder
+	encode TypeEncoder
+}
+
+// RegisterType allows an outside package to teach the parsers a provider specific @type name, such as dse:Point, without
+// replacing the whole parser. A new ValueType is allocated for the type and returned. encode may be nil, in which case
+// values of the type can be read but not serialized. Registering a name again replaces its functions but keeps the
+// ValueType it was first given.
+func RegisterType(typeName string, decode TypeDecoder, encode TypeEncoder) ValueType {
+	customTypesMu.Lock()
+	defer customTypesMu.Unlock()
+
+	if decode == nil {
+		panic("TypeDecoder is nil for type " + typeName)
+	}
+
+	t, ok := customByName[typeName]
+	if !ok {
+		t = customType{name: typeName, vt: NewValueType()}
+	}
+
+	t.decode, t.encode = decode, encode
+
+	customByName[typeName] = t
+	customByType[t.vt] = t
+
+	return t.vt
+}
+
+// RegisterTypeAlias makes alias another @type name for a type already registered with RegisterType, for types that
+// providers write under more than one name. Values are still serialized under the name the type was registered with.
+func RegisterTypeAlias(alias, typeName string) ValueType {
+	customTypesMu.Lock()
+	defer customTypesMu.Unlock()
+
+	t, ok := customByName[typeName]
+	if !ok {
+		panic("type " + typeName + " is not registered, cannot alias it as " + alias)
+	}
+
+	customByName[alias] = t
+
+	return t.vt
+}
+
+// LookupType returns the ValueType and decoder registered for a @type name.
+func LookupType(typeName string) (ValueType, TypeDecoder, bool) {
+	customTypesMu.RLock()
+	defer customTypesMu.RUnlock()
+
+	t, ok := customByName[typeName]
+
+	return t.vt, t.decode, ok
+}
+
+// LookupTypeEncoder returns the @type name and encoder registered for a ValueType. The encoder is nil if the type was
+// registered without one.
+func LookupTypeEncoder(vt ValueType) (string, TypeEncoder, bool) {
+	customTypesMu.RLock()
+	defer customTypesMu.RUnlock()
+
+	t, ok := customByType[vt]
+
+	return t.name, t.encode, ok
+}
@@ -0,0 +1,65 @@
+package graphson
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+)
+
+// Operations and processors understood by a default Gremlin Server configuration.
+const (
+	OpEval           = "eval"
+	OpBytecode       = "bytecode"
+	OpAuthentication = "authentication"
+
+	ProcessorStandard  = ""
+	ProcessorTraversal = "traversal"
+	ProcessorSession   = "session"
+)
+
+// RequestMessage mirrors the message a client sends to Gremlin Server. It is written out by a GraphSONSerializer.
+type RequestMessage struct {
+	RequestID string      `json:"requestId"`
+	Op        string      `json:"op"`
+	Processor string      `json:"processor"`
+	Args      RequestArgs `json:"args"`
+}
+
+// RequestArgs holds the well known request arguments. Empty fields are left out of the serialized message. Anything
+// else a processor expects (sasl, session, batchSize, or the g:Bytecode of a bytecode request) belongs in Other,
+// where it is written as is. Other must not hold the names of the fields above, a serializer returns an error if it does.
+type RequestArgs struct {
+	Gremlin           string               `json:"gremlin"`
+	Bindings          map[string]ValuePair `json:"bindings"`
+	Language          string               `json:"language"`
+	Aliases           map[string]string    `json:"aliases"`
+	EvaluationTimeout time.Duration        `json:"evaluationTimeout"` // written in milliseconds
+	Other             map[string]ValuePair `json:"-"`
+}
+
+// NewEvalRequest returns a sessionless gremlin-groovy script request with a freshly generated request ID.
+func NewEvalRequest(gremlin string, bindings map[string]ValuePair) RequestMessage {
+	return RequestMessage{
+		RequestID: NewRequestID(),
+		Op:        OpEval,
+		Processor: ProcessorStandard,
+		Args: RequestArgs{
+			Gremlin:  gremlin,
+			Bindings: bindings,
+			Language: "gremlin-groovy",
+		},
+	}
+}
+
+// NewRequestID returns a random (version 4) UUID suitable for use as a RequestMessage's RequestID.
+func NewRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic("unable to read random bytes for request id: " + err.Error())
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
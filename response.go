@@ -0,0 +1,171 @@
+package graphson
+
+import (
+	"strings"
+
+	"github.com/buger/jsonparser"
+)
+
+// ResponseStatusCode is the status code returned by Gremlin Server in a response message. See
+// http://tinkerpop.apache.org/docs/3.4.2/dev/provider/#_graph_driver_provider_requirements for their meaning.
+type ResponseStatusCode int
+
+const (
+	StatusSuccess                   = ResponseStatusCode(200)
+	StatusNoContent                 = ResponseStatusCode(204)
+	StatusPartialContent            = ResponseStatusCode(206)
+	StatusUnauthorized              = ResponseStatusCode(401)
+	StatusAuthenticate              = ResponseStatusCode(407)
+	StatusRequestErrorSerialization = ResponseStatusCode(497)
+	StatusMalformedRequest          = ResponseStatusCode(498)
+	StatusInvalidRequestArguments   = ResponseStatusCode(499)
+	StatusServerError               = ResponseStatusCode(500)
+	StatusScriptEvaluationError     = ResponseStatusCode(597)
+	StatusServerTimeout             = ResponseStatusCode(598)
+	StatusServerSerializationError  = ResponseStatusCode(599)
+)
+
+// ResponseMessage mirrors the envelope Gremlin Server wraps around every result. Data, Meta and Status.Attributes are
+// parsed by the same parser that parsed the envelope.
+type ResponseMessage struct {
+	RequestID string         `json:"requestId"`
+	Status    ResponseStatus `json:"status"`
+	Data      ValuePair      `json:"data"`
+	Meta      ValuePair      `json:"meta"`
+}
+
+// ResponseStatus mirrors the status section of a Gremlin Server response message.
+type ResponseStatus struct {
+	Code       ResponseStatusCode `json:"code"`
+	Message    string             `json:"message"`
+	Attributes ValuePair          `json:"attributes"`
+}
+
+// Err returns nil for the 2xx success codes and a ResponseError for everything else. A 407 is a request for
+// authentication rather than a failure, but is still returned as an error so that it cannot be mistaken for a result.
+func (s ResponseStatus) Err() error {
+	if s.Code >= 200 && s.Code < 300 {
+		return nil
+	}
+
+	return ResponseError{Code: s.Code, Message: s.Message}
+}
+
+// ParseResponseMessage walks the envelope of a Gremlin Server response message, its requestId, status and result,
+// handing status.attributes, result.data and result.meta to parse. The version parsers' ParseResponse pass in their own
+// Parse, the envelope being the same in every version. A requestId or status code written as a typed value is unwrapped.
+// Parsing failures are returned as a ParsingError, otherwise a non-success status code is returned as a ResponseError
+// alongside the parsed message.
+func ParseResponseMessage(in []byte, parse func(in []byte) (ValuePair, error)) (response ResponseMessage, err error) {
+	// value location mapping on original json record, using the jsonparser package to avoid as much reflection as we can
+	var paths = [][]string{
+		{"requestId"},
+		{"status", "code"},
+		{"status", "message"},
+		{"status", "attributes"},
+		{"result", "data"},
+		{"result", "meta"},
+	}
+
+	parsingErrors := ParsingErrors{}
+	found := make([]bool, len(paths))
+
+	jsonparser.EachKey(in, func(idx int, value []byte, vt jsonparser.ValueType, err error) {
+		currentError := ParsingError{Operation: "parseResponse", Field: strings.Join(paths[idx], " ")}
+		found[idx] = true
+
+		if err != nil {
+			currentError.Message = err.Error()
+			parsingErrors = append(parsingErrors, currentError)
+			return
+		}
+
+		// requestId and code are usually plain JSON but are typed by some servers, unwrap them if so
+		if vt == jsonparser.Object && idx <= 1 {
+			value, vt, _, err = jsonparser.Get(value, "@value")
+			if err != nil {
+				currentError.Message = err.Error()
+				parsingErrors = append(parsingErrors, currentError)
+				return
+			}
+		}
+
+		switch idx {
+		case 0: // requestId
+			if vt == jsonparser.Null {
+				break
+			}
+
+			id, e := jsonparser.ParseString(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			response.RequestID = id
+
+		case 1: // status -> code
+			code, e := jsonparser.ParseInt(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			response.Status.Code = ResponseStatusCode(code)
+
+		case 2: // status -> message
+			if vt == jsonparser.Null {
+				break
+			}
+
+			message, e := jsonparser.ParseString(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			response.Status.Message = message
+
+		case 3, 4, 5: // status -> attributes, result -> data, result -> meta
+			// a 204 carries a null result, leave the zero ValuePair in place
+			if vt == jsonparser.Null {
+				break
+			}
+
+			// parsers are handed the value as it was written, jsonparser having stripped the quotes from strings
+			if vt == jsonparser.String {
+				value = append(append([]byte{'"'}, value...), '"')
+			}
+
+			vp, e := parse(value)
+			if e != nil {
+				currentError.Message = e.Error()
+				break
+			}
+
+			switch idx {
+			case 3:
+				response.Status.Attributes = vp
+			case 4:
+				response.Data = vp
+			case 5:
+				response.Meta = vp
+			}
+		}
+
+		if currentError.Message != nil {
+			parsingErrors = append(parsingErrors, currentError)
+		}
+
+	}, paths...)
+
+	if !found[1] {
+		parsingErrors = append(parsingErrors, ParsingError{Message: "response message missing status code", Operation: "parseResponse", Field: "status code"})
+	}
+
+	if err := parsingErrors.Combine(); err != nil {
+		return response, err
+	}
+
+	return response, response.Status.Err()
+}
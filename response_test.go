@@ -0,0 +1,29 @@
+package graphson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseResponseMessage(t *testing.T) {
+	handed := []string{}
+	parse := func(in []byte) (ValuePair, error) {
+		handed = append(handed, string(in))
+		return ValuePair{Type: String, Value: string(in)}, nil
+	}
+
+	response, err := ParseResponseMessage([]byte(`{"requestId":{"@type":"g:UUID","@value":"41d2e28a-20a4-4ab0-b379-d810dede3786"},`+
+		`"status":{"message":"","code":{"@type":"g:Int32","@value":206},"attributes":{}},"result":{"data":"a \"b\"","meta":null}}`), parse)
+	assert.Nil(t, err)
+	assert.Equal(t, "41d2e28a-20a4-4ab0-b379-d810dede3786", response.RequestID)
+	assert.Equal(t, StatusPartialContent, response.Status.Code)
+	assert.Equal(t, []string{`{}`, `"a \"b\""`}, handed, "values are handed over as written, a null meta is skipped")
+
+	_, err = ParseResponseMessage([]byte(`{"status":{"message":"boom","code":500},"result":{"data":null}}`), parse)
+	assert.True(t, errors.Is(err, ErrServerError))
+
+	_, err = ParseResponseMessage([]byte(`{"result":{"data":null}}`), parse)
+	assert.NotNil(t, err, "missing status code")
+}
@@ -0,0 +1,101 @@
+package graphson
+
+// Each Gremlin enum is given its own string type so that a parsed token keeps its enum, and stays distinct from a plain
+// string, when used as a map key. The values are those written by TinkerPop 3.4.
+
+// TToken is a g:T, the property keys of an element's id, label, key and value.
+type TToken string
+
+const (
+	TID    = TToken("id")
+	TLabel = TToken("label")
+	TKey   = TToken("key")
+	TValue = TToken("value")
+)
+
+// DirectionToken is a g:Direction.
+type DirectionToken string
+
+const (
+	DirectionOut  = DirectionToken("OUT")
+	DirectionIn   = DirectionToken("IN")
+	DirectionBoth = DirectionToken("BOTH")
+)
+
+// CardinalityToken is a g:Cardinality.
+type CardinalityToken string
+
+const (
+	CardinalitySingle = CardinalityToken("single")
+	CardinalityList   = CardinalityToken("list")
+	CardinalitySet    = CardinalityToken("set")
+)
+
+// OrderToken is a g:Order. Incr and Decr are deprecated in TinkerPop 3.4 but may still be sent by older clients.
+type OrderToken string
+
+const (
+	OrderAsc     = OrderToken("asc")
+	OrderDesc    = OrderToken("desc")
+	OrderShuffle = OrderToken("shuffle")
+	OrderIncr    = OrderToken("incr")
+	OrderDecr    = OrderToken("decr")
+)
+
+// PopToken is a g:Pop.
+type PopToken string
+
+const (
+	PopFirst = PopToken("first")
+	PopLast  = PopToken("last")
+	PopAll   = PopToken("all")
+	PopMixed = PopToken("mixed")
+)
+
+// ScopeToken is a g:Scope.
+type ScopeToken string
+
+const (
+	ScopeGlobal = ScopeToken("global")
+	ScopeLocal  = ScopeToken("local")
+)
+
+// ColumnToken is a g:Column.
+type ColumnToken string
+
+const (
+	ColumnKeys   = ColumnToken("keys")
+	ColumnValues = ColumnToken("values")
+)
+
+// BarrierToken is a g:Barrier.
+type BarrierToken string
+
+const (
+	BarrierNormSack = BarrierToken("normSack")
+)
+
+// OperatorToken is a g:Operator.
+type OperatorToken string
+
+const (
+	OperatorSum     = OperatorToken("sum")
+	OperatorMinus   = OperatorToken("minus")
+	OperatorMult    = OperatorToken("mult")
+	OperatorDiv     = OperatorToken("div")
+	OperatorMin     = OperatorToken("min")
+	OperatorMax     = OperatorToken("max")
+	OperatorAssign  = OperatorToken("assign")
+	OperatorAnd     = OperatorToken("and")
+	OperatorOr      = OperatorToken("or")
+	OperatorAddAll  = OperatorToken("addAll")
+	OperatorSumLong = OperatorToken("sumLong")
+)
+
+// PickToken is a g:Pick.
+type PickToken string
+
+const (
+	PickAny  = PickToken("any")
+	PickNone = PickToken("none")
+)
@@ -0,0 +1,78 @@
+package graphson
+
+// TreeRecord mirrors the Tree record returned by the tree() step. A tree may have any number of roots.
+type TreeRecord struct {
+	Roots []TreeNode `json:"roots"`
+}
+
+// TreeNode is a single entry in a TreeRecord, the Key being the object traversed and Children the objects traversed
+// from it.
+type TreeNode struct {
+	Key      ValuePair  `json:"key"`
+	Children []TreeNode `json:"children"`
+}
+
+// Depth returns the number of levels in the tree, zero for an empty tree.
+func (t TreeRecord) Depth() int {
+	return depth(t.Roots)
+}
+
+// Leaves returns the keys of every node without children, in depth first order.
+func (t TreeRecord) Leaves() []ValuePair {
+	return leaves(t.Roots, nil)
+}
+
+// Walk visits every node in depth first order, roots being at depth 0. Returning false from fn skips the children of
+// the node just visited.
+func (t TreeRecord) Walk(fn func(depth int, node TreeNode) bool) {
+	walk(t.Roots, 0, fn)
+}
+
+// Depth returns the number of levels in the tree rooted at this node, including the node itself.
+func (n TreeNode) Depth() int {
+	return 1 + depth(n.Children)
+}
+
+// Leaves returns the keys of every node without children below and including this node, in depth first order.
+func (n TreeNode) Leaves() []ValuePair {
+	return leaves([]TreeNode{n}, nil)
+}
+
+// Walk visits this node and every node below it in depth first order, this node being at depth 0. Returning false from
+// fn skips the children of the node just visited.
+func (n TreeNode) Walk(fn func(depth int, node TreeNode) bool) {
+	walk([]TreeNode{n}, 0, fn)
+}
+
+func depth(nodes []TreeNode) int {
+	max := 0
+
+	for _, node := range nodes {
+		if d := node.Depth(); d > max {
+			max = d
+		}
+	}
+
+	return max
+}
+
+func leaves(nodes []TreeNode, out []ValuePair) []ValuePair {
+	for _, node := range nodes {
+		if len(node.Children) == 0 {
+			out = append(out, node.Key)
+			continue
+		}
+
+		out = leaves(node.Children, out)
+	}
+
+	return out
+}
+
+func walk(nodes []TreeNode, depth int, fn func(depth int, node TreeNode) bool) {
+	for _, node := range nodes {
+		if fn(depth, node) {
+			walk(node.Children, depth+1, fn)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package graphson
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var valuePairType = reflect.TypeOf(ValuePair{})
+
+// Unmarshal stores the value held by a ValuePair in the value pointed to by dst, saving the walk through a record's
+// properties by hand. Struct fields are matched by their `graphson:"name"` tag, or their name if untagged, fields
+// tagged "-" are skipped and the fields of untagged embedded structs are promoted as encoding/json does.
+//
+// Vertices fill the fields tagged "id" and "label" and any field named after one of their properties. Edges fill "id",
+// "label", "inV", "outV", "inVLabel", "outVLabel" and their properties. A slice field collects every value of a
+// multi-cardinality property while any other field takes the first. A vertex property unmarshalled in to a struct fills
+// "id", "label", "value" and its meta-properties, and a g:Map fills the fields named after its keys.
+//
+// Lists and sets fill slices, maps fill Go maps and nil values leave the destination untouched. A list holding a single
+// value may fill a field that isn't a slice, as valueMap() wraps every value in a list. Fields of type ValuePair, or any
+// type the parsed value is assignable to, such as VertexRecord and time.Time, are set as they are. A value that can't be
+// stored in its destination returns a *TypeMismatchError naming the field.
+func Unmarshal(vp ValuePair, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ParsingError{Message: fmt.Sprintf("destination must be a non-nil pointer, not %T", dst), Operation: "unmarshal"}
+	}
+
+	return unmarshalValue(vp, rv.Elem(), "")
+}
+
+func unmarshalValue(vp ValuePair, rv reflect.Value, field string) error {
+	if rv.Type() == valuePairType {
+		rv.Set(reflect.ValueOf(vp))
+		return nil
+	}
+
+	if vp.Value == nil {
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+
+		return unmarshalValue(vp, rv.Elem(), field)
+	}
+
+	if assignable(vp, rv) {
+		rv.Set(reflect.ValueOf(vp.Value))
+		return nil
+	}
+
+	switch vp.Type {
+	case VertexProperty:
+		if rv.Kind() != reflect.Struct {
+			return unmarshalValue(vp.Value.(VertexPropertyRecord).Value, rv, field)
+		}
+	case EdgeProperty:
+		return unmarshalValue(vp.Value.(Property).Value, rv, field)
+	case Traverser:
+		return unmarshalValue(vp.Value.(TraverserRecord).Value, rv, field)
+	case List, Set, BulkSet:
+		values, err := listValues(vp)
+		if err != nil {
+			return err
+		}
+
+		if rv.Kind() == reflect.Slice {
+			return unmarshalSlice(values, rv, field)
+		}
+
+		// valueMap() results hold every property value in a list, allow them in to single valued fields
+		if len(values) == 1 {
+			return unmarshalValue(values[0], rv, field)
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		return unmarshalStruct(vp, rv, field)
+	case reflect.Map:
+		if vp.Type == Map {
+			return unmarshalMap(vp.Value.(MapRecord), rv, field)
+		}
+	case reflect.String:
+		if name, ok := keyName(vp); ok {
+			rv.SetString(name)
+			return nil
+		}
+
+		if vp.Type == UUID || vp.Type == Class {
+			rv.SetString(vp.Value.(string))
+			return nil
+		}
+	case reflect.Bool:
+		if b, ok := vp.Value.(bool); ok {
+			rv.SetBool(b)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, ok := integer(vp.Value); ok && !rv.OverflowInt(n) {
+			rv.SetInt(n)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, ok := integer(vp.Value); ok && n >= 0 && !rv.OverflowUint(uint64(n)) {
+			rv.SetUint(uint64(n))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		switch n := vp.Value.(type) {
+		case float32:
+			rv.SetFloat(float64(n))
+			return nil
+		case float64:
+			if !rv.OverflowFloat(n) {
+				rv.SetFloat(n)
+				return nil
+			}
+		}
+
+		if n, ok := integer(vp.Value); ok {
+			rv.SetFloat(float64(n))
+			return nil
+		}
+	}
+
+	return mismatch(vp, rv, field)
+}
+
+func unmarshalStruct(vp ValuePair, rv reflect.Value, field string) error {
+	var lookup func(name string) ([]ValuePair, bool)
+
+	switch vp.Type {
+	case Vertex:
+		vertex := vp.Value.(VertexRecord)
+
+		lookup = func(name string) ([]ValuePair, bool) {
+			switch name {
+			case "id":
+				return []ValuePair{vertex.ID}, true
+			case "label":
+				return []ValuePair{{Type: String, Value: vertex.Label}}, true
+			}
+
+			properties, ok := vertex.Properties[name]
+			out := make([]ValuePair, 0, len(properties))
+
+			for _, property := range properties {
+				out = append(out, ValuePair{Type: VertexProperty, Value: property})
+			}
+
+			return out, ok
+		}
+	case VertexProperty:
+		property := vp.Value.(VertexPropertyRecord)
+
+		// a struct such as time.Time holds the property's value, as does a struct describing a map valued property
+		if property.Value.Type == Map || assignable(property.Value, rv) {
+			return unmarshalValue(property.Value, rv, field)
+		}
+
+		lookup = func(name string) ([]ValuePair, bool) {
+			switch name {
+			case "id":
+				return []ValuePair{property.ID}, true
+			case "label":
+				return []ValuePair{{Type: String, Value: property.Label}}, true
+			case "value":
+				return []ValuePair{property.Value}, true
+			}
+
+			value, ok := property.Properties[name]
+
+			return []ValuePair{value}, ok
+		}
+	case Edge:
+		edge := vp.Value.(EdgeRecord)
+
+		lookup = func(name string) ([]ValuePair, bool) {
+			switch name {
+			case "id":
+				return []ValuePair{edge.ID}, true
+			case "label":
+				return []ValuePair{{Type: String, Value: edge.Label}}, true
+			case "inV":
+				return []ValuePair{edge.InV}, true
+			case "outV":
+				return []ValuePair{edge.OutV}, true
+			case "inVLabel":
+				return []ValuePair{{Type: String, Value: edge.InVLabel}}, true
+			case "outVLabel":
+				return []ValuePair{{Type: String, Value: edge.OutVLabel}}, true
+			}
+
+			property, ok := edge.Properties[name]
+
+			return []ValuePair{property.Value}, ok
+		}
+	case Map:
+		m := vp.Value.(MapRecord)
+
+		lookup = func(name string) ([]ValuePair, bool) {
+			value, ok := m.Get(name)
+
+			return []ValuePair{value}, ok
+		}
+	default:
+		return mismatch(vp, rv, field)
+	}
+
+	for _, f := range structFields(rv.Type()) {
+		values, ok := lookup(f.name)
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		fieldPath := joinField(field, f.name)
+		target := fieldByIndex(rv, f.index)
+
+		// every value of a multi-cardinality property goes in to a slice, unless it's a single property holding a list
+		if target.Kind() == reflect.Slice && !holdsList(values) {
+			if err := unmarshalSlice(values, target, fieldPath); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := unmarshalValue(values[0], target, fieldPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fieldByIndex returns the field at index, allocating any nil embedded struct pointers on the way to it.
+func fieldByIndex(rv reflect.Value, index []int) reflect.Value {
+	for i, n := range index {
+		if i > 0 && rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+
+			rv = rv.Elem()
+		}
+
+		rv = rv.Field(n)
+	}
+
+	return rv
+}
+
+// holdsList reports whether values is a single property, or plain value, holding a list rather than multiple values.
+func holdsList(values []ValuePair) bool {
+	if len(values) != 1 {
+		return false
+	}
+
+	vp := values[0]
+	if vp.Type == VertexProperty {
+		vp = vp.Value.(VertexPropertyRecord).Value
+	}
+
+	switch vp.Type {
+	case List, Set, BulkSet, ByteBuffer:
+		return true
+	}
+
+	return false
+}
+
+func unmarshalSlice(values []ValuePair, rv reflect.Value, field string) error {
+	out := reflect.MakeSlice(rv.Type(), len(values), len(values))
+
+	for i, value := range values {
+		if err := unmarshalValue(value, out.Index(i), fmt.Sprintf("%s[%d]", field, i)); err != nil {
+			return err
+		}
+	}
+
+	rv.Set(out)
+
+	return nil
+}
+
+func unmarshalMap(m MapRecord, rv reflect.Value, field string) error {
+	if rv.IsNil() {
+		rv.Set(reflect.MakeMapWithSize(rv.Type(), len(m.Entries)))
+	}
+
+	for _, entry := range m.Entries {
+		key := reflect.New(rv.Type().Key()).Elem()
+		if err := unmarshalValue(entry.Key, key, field); err != nil {
+			return err
+		}
+
+		if err := mapKey(key, field); err != nil {
+			return err
+		}
+
+		name := joinField(field, fmt.Sprint(key.Interface()))
+
+		value := reflect.New(rv.Type().Elem()).Elem()
+		if err := unmarshalValue(entry.Value, value, name); err != nil {
+			return err
+		}
+
+		rv.SetMapIndex(key, value)
+	}
+
+	return nil
+}
+
+func listValues(vp ValuePair) ([]ValuePair, error) {
+	if vp.Type == BulkSet {
+		return vp.Value.(BulkSetRecord).Expand()
+	}
+
+	return vp.Value.([]ValuePair), nil
+}
+
+func assignable(vp ValuePair, rv reflect.Value) bool {
+	return vp.Value != nil && reflect.TypeOf(vp.Value).AssignableTo(rv.Type())
+}
+
+func integer(value interface{}) (int64, bool) {
+	switch n := value.(type) {
+	case int:
+		return int64(n), true
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	}
+
+	return 0, false
+}
+
+// mapKey returns an error for a key that can't be stored in a Go map, such as a vertex unmarshalled in to an interface.
+func mapKey(key reflect.Value, field string) error {
+	if hashable(key) {
+		return nil
+	}
+
+	return ParsingError{Message: fmt.Sprintf("cannot use unhashable %T as a map key", key.Interface()), Operation: "unmarshal", Field: field}
+}
+
+func mismatch(vp ValuePair, rv reflect.Value, field string) error {
+	return &TypeMismatchError{Expected: expectedType(rv.Type()), Actual: vp.Type, Field: field}
+}
+
+// expectedType returns the ValueType a Go type is marshalled as, the one a destination of that type is filled from.
+func expectedType(t reflect.Type) ValueType {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	vp, err := marshalValue(reflect.Zero(t), "")
+	if err != nil {
+		return Unknown
+	}
+
+	return vp.Type
+}
@@ -0,0 +1,201 @@
+package graphson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testNamed struct {
+	Label string `graphson:"label"`
+	Name  string `graphson:"name"`
+}
+
+type testAged struct {
+	Age int `graphson:"age"`
+}
+
+type testEmbedding struct {
+	testNamed
+	*testAged `graphson:"-"`
+	*Timestamped
+	Name string `graphson:"name"`
+}
+
+type Timestamped struct {
+	Since int64 `graphson:"since"`
+}
+
+func testVertex() ValuePair {
+	property := func(label string, value ValuePair) []VertexPropertyRecord {
+		return []VertexPropertyRecord{{Label: label, Value: value}}
+	}
+
+	return ValuePair{Type: Vertex, Value: VertexRecord{
+		ID:    ValuePair{Type: Int64, Value: int64(1)},
+		Label: "person",
+		Properties: map[string][]VertexPropertyRecord{
+			"name":  property("name", ValuePair{Type: String, Value: "marko"}),
+			"age":   property("age", ValuePair{Type: Int32, Value: 29}),
+			"since": property("since", ValuePair{Type: Int64, Value: int64(2010)}),
+		},
+	}}
+}
+
+type testLocation struct {
+	Name      string `graphson:"value"`
+	StartTime int    `graphson:"startTime"`
+	EndTime   *int   `graphson:"endTime"`
+}
+
+type testPerson struct {
+	ID        int64          `graphson:"id"`
+	Label     string         `graphson:"label"`
+	Locations []testLocation `graphson:"location"`
+	Places    []string       `graphson:"location"`
+	Age       *int           `graphson:"age"`
+	Ignored   string         `graphson:"-"`
+}
+
+func TestUnmarshalVertex(t *testing.T) {
+	int32s := func(i int) ValuePair { return ValuePair{Type: Int32, Value: i} }
+	location := func(name string, properties map[string]ValuePair) VertexPropertyRecord {
+		return VertexPropertyRecord{Label: "location", Value: ValuePair{Type: String, Value: name}, Properties: properties}
+	}
+
+	vp := ValuePair{Type: Vertex, Value: VertexRecord{
+		ID:    ValuePair{Type: Int64, Value: int64(1)},
+		Label: "person",
+		Properties: map[string][]VertexPropertyRecord{
+			"location": {
+				location("san diego", map[string]ValuePair{"startTime": int32s(1997), "endTime": int32s(2001)}),
+				location("santa fe", map[string]ValuePair{"startTime": int32s(2005)}),
+			},
+		},
+	}}
+
+	person := testPerson{Ignored: "kept"}
+	assert.Nil(t, Unmarshal(vp, &person))
+
+	assert.Equal(t, int64(1), person.ID)
+	assert.Equal(t, "person", person.Label)
+	assert.Equal(t, []string{"san diego", "santa fe"}, person.Places, "a slice collects every value of a property")
+	assert.Nil(t, person.Age, "a missing property leaves a pointer nil")
+	assert.Equal(t, "kept", person.Ignored, "a field tagged - is left alone")
+
+	assert.Len(t, person.Locations, 2)
+	assert.Equal(t, "san diego", person.Locations[0].Name, "a struct reads the value through its value tag")
+	assert.Equal(t, 1997, person.Locations[0].StartTime)
+	assert.Equal(t, 2001, *person.Locations[0].EndTime)
+	assert.Nil(t, person.Locations[1].EndTime)
+}
+
+func TestUnmarshalEdge(t *testing.T) {
+	vp := ValuePair{Type: Edge, Value: EdgeRecord{
+		ID:         ValuePair{Type: Int32, Value: 13},
+		Label:      "develops",
+		InV:        ValuePair{Type: Int32, Value: 10},
+		OutV:       ValuePair{Type: Int32, Value: 1},
+		Properties: map[string]Property{"since": {Key: "since", Value: ValuePair{Type: Int32, Value: 2009}}},
+	}}
+
+	var develops struct {
+		ID    ValuePair `graphson:"id"`
+		Label string    `graphson:"label"`
+		In    int       `graphson:"inV"`
+		Out   uint      `graphson:"outV"`
+		Since int16     `graphson:"since"`
+	}
+	assert.Nil(t, Unmarshal(vp, &develops))
+
+	assert.Equal(t, ValuePair{Type: Int32, Value: 13}, develops.ID, "a ValuePair field takes the value as it is")
+	assert.Equal(t, "develops", develops.Label)
+	assert.Equal(t, 10, develops.In)
+	assert.Equal(t, uint(1), develops.Out)
+	assert.Equal(t, int16(2009), develops.Since)
+}
+
+func TestUnmarshalEmbedded(t *testing.T) {
+	var out testEmbedding
+	assert.Nil(t, Unmarshal(testVertex(), &out))
+
+	assert.Equal(t, "person", out.Label, "fields of an embedded struct are promoted")
+	assert.Equal(t, "marko", out.Name)
+	assert.Equal(t, "", out.testNamed.Name, "the outer field shadows the promoted one")
+	assert.Nil(t, out.testAged, "an embedded struct tagged - is skipped")
+	assert.Equal(t, int64(2010), out.Since, "a nil embedded pointer is allocated")
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	double := func(f float64) ValuePair { return ValuePair{Type: Double, Value: f} }
+
+	vp := ValuePair{Type: Map, Value: MapRecord{Entries: []MapEntry{
+		{Key: ValuePair{Type: T, Value: TID}, Value: ValuePair{Type: Int64, Value: int64(4)}},
+		{Key: ValuePair{Type: String, Value: "name"}, Value: ValuePair{Type: List, Value: []ValuePair{{Type: String, Value: "josh"}}}},
+		{Key: ValuePair{Type: String, Value: "scores"}, Value: ValuePair{Type: List, Value: []ValuePair{double(1.5), double(2)}}},
+	}}}
+
+	var josh struct {
+		ID     int64     `graphson:"id"`
+		Name   string    `graphson:"name"`
+		Scores []float64 `graphson:"scores"`
+	}
+	assert.Nil(t, Unmarshal(vp, &josh))
+	assert.Equal(t, int64(4), josh.ID)
+	assert.Equal(t, "josh", josh.Name)
+	assert.Equal(t, []float64{1.5, 2}, josh.Scores)
+
+	var m map[string][]float64
+	assert.Nil(t, Unmarshal(vp, &struct{}{}))
+	assert.NotNil(t, Unmarshal(vp, &m), "name holds a string")
+
+	counts := ValuePair{Type: Map, Value: MapRecord{Entries: []MapEntry{
+		{Key: ValuePair{Type: String, Value: "a"}, Value: ValuePair{Type: Int64, Value: int64(1)}},
+		{Key: ValuePair{Type: String, Value: "b"}, Value: ValuePair{Type: Int64, Value: int64(2)}},
+	}}}
+
+	var out map[string]int
+	assert.Nil(t, Unmarshal(counts, &out))
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, out)
+}
+
+func TestUnmarshalMismatch(t *testing.T) {
+	vp := testVertex()
+
+	var wrong struct {
+		Name int `graphson:"name"`
+	}
+	err := Unmarshal(vp, &wrong)
+
+	var mismatch *TypeMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, &TypeMismatchError{Expected: Int32, Actual: String, Field: "name"}, mismatch)
+
+	var names struct {
+		Name []int `graphson:"name"`
+	}
+	err = Unmarshal(vp, &names)
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, "name[0]", mismatch.Field)
+
+	var small int8
+	assert.NotNil(t, Unmarshal(ValuePair{Type: Int32, Value: 1000}, &small), "overflows int8")
+	assert.NotNil(t, Unmarshal(vp, wrong), "destination is not a pointer")
+}
+
+func TestUnmarshalUnhashableKey(t *testing.T) {
+	vp := ValuePair{Type: Map, Value: MapRecord{Entries: []MapEntry{
+		{Key: testVertex(), Value: ValuePair{Type: Int64, Value: int64(1)}},
+	}}}
+
+	var out map[interface{}]int64
+	assert.NotPanics(t, func() {
+		assert.NotNil(t, Unmarshal(vp, &out), "a vertex can't key a Go map")
+	})
+
+	// a key type the vertex is unmarshalled in to keeps working
+	var byStruct map[testNamed]int64
+	assert.Nil(t, Unmarshal(vp, &byStruct))
+	assert.Equal(t, map[testNamed]int64{{Label: "person", Name: "marko"}: 1}, byStruct)
+}